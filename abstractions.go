@@ -0,0 +1,376 @@
+package shaders
+
+import (
+	"errors"
+	"strings"
+	"unsafe"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+var (
+	ErrStringNotNullTerminated = errors.New("string not null terminated")
+)
+
+// VertexArray ties data layout with vertex buffer(s).
+// Is aware of data layout via VertexAttribPointer* calls.
+type VertexArray struct {
+	rid uint32
+}
+
+// AttribLayout is a low level configuration struct
+// for adding vertex buffers attribute layouts to a vertex array object.
+type AttribLayout struct {
+	// The OpenGL program identifier.
+	Program Program
+	// Type is a OpenGL enum representing the underlying type. Valid types include
+	// gl.FLOAT, gl.UNSIGNED_INT, gl.UNSIGNED_BYTE, gl.BYTE etc.
+	Type uint32
+	// Name is the identifier of the attribute in the
+	// vertex shader source code finished with a null terminator.
+	Name string
+	// Packing is a value between 1 and 4 and represents how many
+	// of the type are present at the attribute location.
+	//
+	// Example:
+	// When w orking with a vec3 attribute in the shader source code
+	// with a gl.Float type, then the Packing is 3 since there are
+	// 3 floats packed at each attribute location.
+	Packing int
+	// Stride is the distance in bytes between attributes in the buffer.
+	Stride int
+	// Offset is the starting offset with which to start
+	// traversing the vertex buffer.
+	Offset int
+	// specifies whether fixed-point data values should be normalized (when true)
+	// or converted directly as fixed-point values (when false) when they are accessed.
+	// Usually left as false?
+	Normalize bool
+	// Divisor is the number of instances drawn before the attribute advances
+	// to the next element in the buffer. The zero value advances the attribute
+	// every vertex. A Divisor of 1 makes the buffer hold per-instance data
+	// such as the position or color of each instance in an instanced draw.
+	Divisor int
+}
+
+func NewVAO() VertexArray {
+	// Configure the Vertex Array Object.
+	var vao uint32
+	gl.GenVertexArrays(1, &vao)
+	trackCreate(kindVertexArray, vao)
+	gl.BindVertexArray(vao)
+	logCall("glBindVertexArray")
+	return VertexArray{rid: vao}
+}
+
+func (vao VertexArray) Bind() {
+	trackUse(kindVertexArray, vao.rid)
+	gl.BindVertexArray(vao.rid)
+	logCall("glBindVertexArray")
+}
+func (vao VertexArray) Unbind() {
+	gl.BindVertexArray(0)
+}
+func (vao VertexArray) Delete() {
+	if trackDelete(kindVertexArray, vao.rid) {
+		gl.DeleteVertexArrays(1, &vao.rid)
+	}
+}
+
+func (vao VertexArray) AddAttribute(vbo VertexBuffer, layout AttribLayout) error {
+	if !strings.HasSuffix(layout.Name, "\x00") {
+		return ErrStringNotNullTerminated
+	}
+	vao.Bind()
+	vbo.Bind()
+	loc := gl.GetAttribLocation(layout.Program.rid, gl.Str(layout.Name))
+	if err := checkCall("glGetAttribLocation"); err != nil {
+		return err
+	}
+	if loc < 0 {
+		return ErrNoAttribute
+	}
+	vertAttrib := uint32(loc)
+	gl.EnableVertexAttribArray(vertAttrib)
+	if err := checkCall("glEnableVertexAttribArray"); err != nil {
+		return err
+	}
+	// VAO: Vertex Array Object is bound to the vertex buffer on this call.
+	// What this line is saying is that `vertAttrib`` index is going to be bound
+	// to the current gl.ARRAY_BUFFER (vbo).
+	// It also stores size, type, normalized, stride and pointer as vertex array
+	// state, in addition to the current vertex array buffer object binding. https://registry.khronos.org/OpenGL-Refpages/gl4/html/glVertexAttribPointer.xhtml
+	gl.VertexAttribPointerWithOffset(vertAttrib, int32(layout.Packing), layout.Type,
+		layout.Normalize, int32(layout.Stride), uintptr(layout.Offset))
+	if err := checkCall("glVertexAttribPointer"); err != nil {
+		return err
+	}
+	gl.VertexAttribDivisor(vertAttrib, uint32(layout.Divisor))
+	return checkErrorAfter("glVertexAttribDivisor")
+}
+
+// VertexBuffer contains bytes, no information on the layout or type.
+type VertexBuffer struct {
+	// Renderer ID. If using OpenGL is the id set on buffer creation.
+	rid uint32
+}
+
+func NewVertexBuffer[T any](data []T) (VertexBuffer, error) {
+	return newVertexBuffer(gl.STATIC_DRAW, data)
+}
+
+// NewDynamicVertexBuffer creates a vertex buffer whose contents are expected to
+// be modified often with UpdateVertexBuffer, i.e: per-instance positions.
+func NewDynamicVertexBuffer[T any](data []T) (VertexBuffer, error) {
+	return newVertexBuffer(gl.DYNAMIC_DRAW, data)
+}
+
+// UpdateVertexBuffer overwrites the buffer contents starting at element offset
+// with data. The buffer can not grow, data must fit in the buffer.
+func UpdateVertexBuffer[T any](vbo VertexBuffer, offset int, data []T) error {
+	if len(data) == 0 {
+		return nil
+	}
+	vertexSize := int(unsafe.Sizeof(data[0]))
+	vbo.Bind()
+	gl.BufferSubData(gl.ARRAY_BUFFER, offset*vertexSize, vertexSize*len(data), unsafe.Pointer(&data[0]))
+	return checkErrorAfter("glBufferSubData")
+}
+
+func newVertexBuffer[T any](usage uint32, data []T) (VertexBuffer, error) {
+	var vbo VertexBuffer
+	vertexSize := unsafe.Sizeof(data[0])
+	vertPtr := unsafe.Pointer(&data[0])
+	gl.GenBuffers(1, &vbo.rid)
+	trackCreate(kindBuffer, vbo.rid)
+	gl.BindBuffer(gl.ARRAY_BUFFER, vbo.rid)
+	if err := checkCall("glBindBuffer"); err != nil {
+		return vbo, err
+	}
+	gl.BufferData(gl.ARRAY_BUFFER, int(vertexSize)*len(data), vertPtr, usage)
+	return vbo, checkErrorAfter("glBufferData")
+}
+
+func (vbo VertexBuffer) Bind() {
+	trackUse(kindBuffer, vbo.rid)
+	gl.BindBuffer(gl.ARRAY_BUFFER, vbo.rid)
+	logCall("glBindBuffer")
+}
+func (vbo VertexBuffer) Unbind() {
+	gl.BindBuffer(gl.ARRAY_BUFFER, 0)
+}
+func (vbo VertexBuffer) Delete() {
+	if trackDelete(kindBuffer, vbo.rid) {
+		gl.DeleteBuffers(1, &vbo.rid)
+	}
+}
+
+type IndexBuffer struct {
+	// Renderer ID. If using OpenGL is the id set on buffer creation.
+	rid uint32
+	// count is the number of indices in the buffer.
+	count int32
+}
+
+func NewIndexBuffer(data []uint32) (IndexBuffer, error) {
+	return newIndexBuffer(gl.STATIC_DRAW, data)
+}
+
+func newIndexBuffer(usage uint32, data []uint32) (IndexBuffer, error) {
+	ibo := IndexBuffer{count: int32(len(data))}
+	const IndexSize = unsafe.Sizeof(data[0])
+	vertPtr := unsafe.Pointer(&data[0])
+	gl.GenBuffers(1, &ibo.rid)
+	trackCreate(kindBuffer, ibo.rid)
+	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, ibo.rid)
+	if err := checkCall("glBindBuffer"); err != nil {
+		return ibo, err
+	}
+	gl.BufferData(gl.ELEMENT_ARRAY_BUFFER, int(IndexSize)*len(data), vertPtr, usage)
+	return ibo, checkErrorAfter("glBufferData")
+}
+
+// Count returns the number of indices in the buffer.
+func (vbo IndexBuffer) Count() int { return int(vbo.count) }
+
+func (vbo IndexBuffer) Bind() {
+	trackUse(kindBuffer, vbo.rid)
+	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, vbo.rid)
+	logCall("glBindBuffer")
+}
+func (vbo IndexBuffer) Unbind() {
+	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, 0)
+}
+func (vbo IndexBuffer) Delete() {
+	if trackDelete(kindBuffer, vbo.rid) {
+		gl.DeleteBuffers(1, &vbo.rid)
+	}
+}
+
+// Vertex and Fragment are null terminated strings with source code.
+type ShaderSource struct {
+	// Vertex and Fragment are null terminated strings with source code.
+	Vertex   string
+	Fragment string
+}
+
+// NewProgram compiles and links the shaders of ss. If ss has no vertex shader,
+// as returned by ParseCombinedBasic for files with only a fragment stage,
+// the program is created with NewFragmentProgram.
+func NewProgram(ss ShaderSource) (prog Program, err error) {
+	if ss.Vertex == "" {
+		return NewFragmentProgram(ss.Fragment)
+	}
+	prog.rid, err = CompileBasic(ss.Vertex, ss.Fragment)
+	trackCreate(kindProgram, prog.rid)
+	return prog, err
+}
+
+type Program struct {
+	rid uint32
+}
+
+func (p Program) Bind() {
+	trackUse(kindProgram, p.rid)
+	gl.UseProgram(p.rid)
+	logCall("glUseProgram")
+}
+
+// BindFrag binds the fragment shader output variable name to the first color
+// attachment. It returns ErrUnsupported on OpenGL ES, where outputs are assigned
+// with layout qualifiers, i.e: layout(location = 0) out vec4 color.
+func (p Program) BindFrag(name string) error {
+	if !strings.HasSuffix(name, "\x00") {
+		return ErrStringNotNullTerminated
+	}
+	if contextVersion().es {
+		return ErrUnsupported
+	}
+	gl.BindFragDataLocation(p.rid, 0, gl.Str(name))
+	return checkCall("glBindFragDataLocation")
+}
+
+func (p Program) Unbind() {
+	gl.UseProgram(0)
+}
+func (p Program) Delete() {
+	if trackDelete(kindProgram, p.rid) {
+		gl.DeleteProgram(p.rid)
+	}
+}
+
+// SetUniformName1f sets the float uniform name of the program, which must be bound.
+// It returns ErrNoUniform if the program has no active uniform with the name.
+func (p Program) SetUniformName1f(name string, v0 float32) error {
+	loc, err := p.uniformLocation(name)
+	if err != nil {
+		return err
+	}
+	gl.Uniform1f(loc, v0)
+	return checkCall("glUniform1f")
+}
+
+// SetUniformName1i sets the int or sampler uniform name of the program, which must be bound.
+func (p Program) SetUniformName1i(name string, v0 int32) error {
+	loc, err := p.uniformLocation(name)
+	if err != nil {
+		return err
+	}
+	gl.Uniform1i(loc, v0)
+	return checkCall("glUniform1i")
+}
+
+func (p Program) SetUniformName2f(name string, v0, v1 float32) error {
+	loc, err := p.uniformLocation(name)
+	if err != nil {
+		return err
+	}
+	gl.Uniform2f(loc, v0, v1)
+	return checkCall("glUniform2f")
+}
+
+func (p Program) SetUniformName3f(name string, v0, v1, v2 float32) error {
+	loc, err := p.uniformLocation(name)
+	if err != nil {
+		return err
+	}
+	gl.Uniform3f(loc, v0, v1, v2)
+	return checkCall("glUniform3f")
+}
+
+func (p Program) SetUniformName4f(name string, v0, v1, v2, v3 float32) error {
+	loc, err := p.uniformLocation(name)
+	if err != nil {
+		return err
+	}
+	gl.Uniform4f(loc, v0, v1, v2, v3)
+	return checkCall("glUniform4f")
+}
+
+func (p Program) uniformLocation(name string) (int32, error) {
+	if !strings.HasSuffix(name, "\x00") {
+		return -1, ErrStringNotNullTerminated
+	}
+	loc := gl.GetUniformLocation(p.rid, gl.Str(name))
+	if err := checkCall("glGetUniformLocation"); err != nil {
+		return -1, err
+	}
+	if loc < 0 {
+		return -1, ErrNoUniform
+	}
+	return loc, nil
+}
+
+// ClearErrors discards all OpenGL error flags set so far. It is
+// useful right after context creation since some drivers leave stale errors.
+func ClearErrors() {
+	glClearError()
+}
+
+func glClearError() {
+	for i := 0; i < maxErrorFlags && gl.GetError() != gl.NO_ERROR; i++ {
+	}
+}
+
+// maxErrorFlags bounds the glGetError loop. Implementations keep a small
+// number of error flags so a never ending stream of errors
+// means there is no current context and it makes no sense to loop forever.
+const maxErrorFlags = 32
+
+func glCheckError() error {
+	code := gl.GetError()
+	if code == gl.NO_ERROR {
+		return nil
+	}
+	errs := GLErrors{code}
+	for len(errs) < maxErrorFlags {
+		code = gl.GetError()
+		if code == gl.NO_ERROR {
+			break
+		}
+		errs = append(errs, code)
+	}
+	return errs
+}
+
+type GLErrors []uint32
+
+func (ge GLErrors) Error() (errstr string) {
+	if len(ge) == 0 {
+		return "no gl errors"
+	}
+	for i := range ge {
+		var s string
+		// Error codes are contiguous, from GL_INVALID_ENUM to GL_CONTEXT_LOST.
+		if ge[i] >= gl.INVALID_ENUM && ge[i] <= gl.CONTEXT_LOST {
+			s = enumLabel(ge[i], "")
+		} else {
+			s = "unknown error enum " + EnumName(ge[i])
+		}
+		errstr += s
+		if i != len(ge)-1 {
+			errstr += "; "
+		}
+	}
+	return errstr
+}
@@ -0,0 +1,213 @@
+package shaders
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+
+	"github.com/soypat/shaders/backend/fake"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+const testVertex = `#version 330
+in vec3 pos;
+in vec2 offset;
+void main() { gl_Position = vec4(pos.xy + offset, pos.z, 1.0); }
+` + "\x00"
+
+const testFragment = `#version 330
+uniform float u_time;
+out vec4 color;
+void main() { color = vec4(u_time); }
+` + "\x00"
+
+func newFake(t *testing.T) *fake.Backend {
+	t.Helper()
+	b := fake.New(16, 16)
+	SetBackend(b)
+	return b
+}
+
+func checkNoGLErrors(t *testing.T) {
+	t.Helper()
+	if err := glCheckError(); err != nil {
+		t.Errorf("unexpected OpenGL errors: %v", err)
+	}
+}
+
+func TestBufferUpload(t *testing.T) {
+	b := newFake(t)
+	before := b.Objects()
+	vertices := []float32{1, 2, 3, 4}
+	vbo, err := NewVertexBuffer(vertices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := b.Buffer(vbo.rid)
+	if buf == nil || buf.Usage != gl.STATIC_DRAW {
+		t.Fatalf("got buffer %+v, want static draw buffer", buf)
+	}
+	if got := unsafe.Slice((*float32)(unsafe.Pointer(&buf.Data[0])), len(buf.Data)/4); !equal(got, vertices) {
+		t.Errorf("uploaded %v, want %v", got, vertices)
+	}
+	if err := UpdateVertexBuffer(vbo, 2, []float32{9}); err != nil {
+		t.Fatal(err)
+	}
+	if got := unsafe.Slice((*float32)(unsafe.Pointer(&buf.Data[0])), 4); !equal(got, []float32{1, 2, 9, 4}) {
+		t.Errorf("updated to %v", got)
+	}
+	// The buffer can not grow.
+	if err := UpdateVertexBuffer(vbo, 3, []float32{1, 2}); err == nil {
+		t.Error("expected error updating past the end of the buffer")
+	}
+
+	dynamic, err := NewDynamicVertexBuffer([]uint8{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf := b.Buffer(dynamic.rid); buf.Usage != gl.DYNAMIC_DRAW || len(buf.Data) != 3 {
+		t.Errorf("got dynamic buffer %+v", buf)
+	}
+
+	ibo, err := NewIndexBuffer([]uint32{0, 1, 2, 2, 3, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ibo.Count() != 6 || len(b.Buffer(ibo.rid).Data) != 6*4 {
+		t.Errorf("got index buffer of %d indices and %d bytes", ibo.Count(), len(b.Buffer(ibo.rid).Data))
+	}
+
+	vbo.Delete()
+	dynamic.Delete()
+	ibo.Delete()
+	if b.Objects() != before {
+		t.Errorf("%d objects leaked", b.Objects()-before)
+	}
+	checkNoGLErrors(t)
+}
+
+func TestAddAttribute(t *testing.T) {
+	b := newFake(t)
+	prog, err := NewProgram(ShaderSource{Vertex: testVertex, Fragment: testFragment})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer prog.Delete()
+	positions, err := NewVertexBuffer(make([]float32, 3*4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer positions.Delete()
+	offsets, err := NewVertexBuffer(make([]float32, 2*2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer offsets.Delete()
+	vao := NewVAO()
+	defer vao.Delete()
+
+	err = vao.AddAttribute(positions, AttribLayout{Program: prog, Type: gl.FLOAT, Name: "pos\x00", Packing: 3, Stride: 3 * 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = vao.AddAttribute(offsets, AttribLayout{Program: prog, Type: gl.FLOAT, Name: "offset\x00", Packing: 2, Stride: 4 * 4, Offset: 8, Divisor: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	locations := b.Program(prog.rid).Attribs
+	attribs := b.VertexArray(vao.rid).Attribs
+	tests := []struct {
+		name string
+		want fake.VertexAttrib
+	}{
+		{"pos", fake.VertexAttrib{Enabled: true, Buffer: positions.rid, Size: 3, Type: gl.FLOAT, Stride: 12}},
+		{"offset", fake.VertexAttrib{Enabled: true, Buffer: offsets.rid, Size: 2, Type: gl.FLOAT, Stride: 16, Offset: 8, Divisor: 1}},
+	}
+	for _, test := range tests {
+		loc, ok := locations[test.name]
+		if !ok {
+			t.Fatalf("program has no attribute %s", test.name)
+		}
+		if got := attribs[uint32(loc)]; got == nil || *got != test.want {
+			t.Errorf("%s: got %+v, want %+v", test.name, got, test.want)
+		}
+	}
+
+	err = vao.AddAttribute(positions, AttribLayout{Program: prog, Type: gl.FLOAT, Name: "normal\x00", Packing: 3})
+	if !errors.Is(err, ErrNoAttribute) {
+		t.Errorf("missing attribute: got %v, want ErrNoAttribute", err)
+	}
+	err = vao.AddAttribute(positions, AttribLayout{Program: prog, Type: gl.FLOAT, Name: "pos", Packing: 3})
+	if !errors.Is(err, ErrStringNotNullTerminated) {
+		t.Errorf("unterminated name: got %v, want ErrStringNotNullTerminated", err)
+	}
+	checkNoGLErrors(t)
+}
+
+func TestUniformLookup(t *testing.T) {
+	b := newFake(t)
+	prog, err := NewProgram(ShaderSource{Vertex: testVertex, Fragment: testFragment})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer prog.Delete()
+	prog.Bind()
+	if err := prog.SetUniformName1f("u_time\x00", 1.5); err != nil {
+		t.Fatal(err)
+	}
+	p := b.Program(prog.rid)
+	if got := p.Values[p.Uniforms["u_time"]]; len(got) != 1 || got[0] != 1.5 {
+		t.Errorf("u_time: got %v, want [1.5]", got)
+	}
+	err = prog.SetUniformName1f("u_missing\x00", 1)
+	if !errors.Is(err, ErrNoUniform) {
+		t.Errorf("missing uniform: got %v, want ErrNoUniform", err)
+	}
+	if err := prog.SetUniformName1f("u_time", 1); !errors.Is(err, ErrStringNotNullTerminated) {
+		t.Errorf("unterminated name: got %v, want ErrStringNotNullTerminated", err)
+	}
+	checkNoGLErrors(t)
+}
+
+func TestGLCheckErrorBounded(t *testing.T) {
+	b := newFake(t)
+	if err := glCheckError(); err != nil {
+		t.Fatalf("fresh context has errors: %v", err)
+	}
+	b.InjectError(gl.INVALID_ENUM)
+	b.InjectError(gl.INVALID_VALUE)
+	var errs GLErrors
+	if err := glCheckError(); !errors.As(err, &errs) || len(errs) != 2 || errs[0] != gl.INVALID_ENUM || errs[1] != gl.INVALID_VALUE {
+		t.Errorf("got %v, want invalid enum and invalid value", err)
+	}
+	// Flags are cleared by reading them.
+	if err := glCheckError(); err != nil {
+		t.Errorf("got %v after reading errors", err)
+	}
+	// A never ending stream of errors, as returned with no current context, is not read forever.
+	SetBackend(errorStream{b})
+	defer SetBackend(b)
+	if err := glCheckError(); !errors.As(err, &errs) || len(errs) != maxErrorFlags {
+		t.Errorf("got %d errors, want %d", len(errs), maxErrorFlags)
+	}
+	ClearErrors()
+}
+
+// errorStream always has an error flag set.
+type errorStream struct {
+	*fake.Backend
+}
+
+func (errorStream) GetError() uint32 { return gl.INVALID_OPERATION }
+
+func equal[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,222 @@
+// Package app creates a GLFW window with an OpenGL context and runs its
+// render loop, the setup every example and tool of this module otherwise repeats.
+//
+//	a, err := app.New(app.Config{Title: "Triangle"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer a.Close()
+//	// Create programs, buffers etc.
+//	err = a.Run(nil, func(f app.Frame) error {
+//		a.Renderer.Clear(color.Black, 1, 0)
+//		return a.Renderer.Draw(vao, ibo, program)
+//	})
+//
+// Importing the package locks the main goroutine to the main OS thread, which GLFW
+// requires, so New and Run must be called from the main goroutine.
+package app
+
+import (
+	"errors"
+	"runtime"
+	"time"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+	"github.com/soypat/shaders"
+	"github.com/soypat/shaders/internal/bindings"
+)
+
+func init() {
+	// GLFW event handling must run on the main OS thread
+	runtime.LockOSThread()
+}
+
+// Config configures the window and OpenGL context created by New.
+type Config struct {
+	// Width and Height of the window in screen coordinates. Default to 800x800.
+	Width, Height int
+	// Title of the window.
+	Title string
+	// Major and Minor are the requested OpenGL version. Desktop contexts
+	// default to a 4.1 core profile, the newest macOS supports, and ES contexts to 3.0.
+	Major, Minor int
+	// ES requests an OpenGL ES context.
+	ES bool
+	// DisableVSync swaps buffers as soon as a frame is drawn instead of waiting
+	// for the display refresh. Useful for benchmarking.
+	DisableVSync bool
+	// Resizable lets the user resize the window. The viewport follows the window size.
+	Resizable bool
+	// IgnoreEscape keeps the window open when the Escape key is pressed.
+	IgnoreEscape bool
+}
+
+// Frame holds the timing of the frame being updated and drawn.
+type Frame struct {
+	// Count is the number of frames drawn before this one.
+	Count uint64
+	// Time elapsed since Run was called.
+	Time time.Duration
+	// Delta is the time elapsed since the previous frame, zero for the first frame.
+	Delta time.Duration
+	// FPS is the number of frames drawn during the last second.
+	FPS float64
+}
+
+// App is a window with a current OpenGL context.
+type App struct {
+	// Window is the GLFW window, for handling input.
+	Window *glfw.Window
+	// Renderer draws to the window framebuffer.
+	Renderer *shaders.Renderer
+	// Uniforms are updated by Run before every frame with its timing, the framebuffer
+	// size and the cursor position and left mouse button. Set them on a program
+	// with Uniforms.Apply.
+	Uniforms shaders.StandardUniforms
+	cfg      Config
+	stopped  bool
+}
+
+// New creates a window, makes its OpenGL context current, initializes the go-gl
+// bindings for it and sets the matching package shaders backend, unless one was
+// selected with the gl33, gl41 or gles30 build tag.
+func New(cfg Config) (*App, error) {
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		cfg.Width, cfg.Height = 800, 800
+	}
+	if cfg.Major == 0 {
+		cfg.Major, cfg.Minor = 4, 1
+		if cfg.ES {
+			cfg.Major, cfg.Minor = 3, 0
+		}
+	}
+	if err := glfw.Init(); err != nil {
+		return nil, err
+	}
+	glfw.WindowHint(glfw.Resizable, glfwBool(cfg.Resizable))
+	glfw.WindowHint(glfw.ContextVersionMajor, cfg.Major)
+	glfw.WindowHint(glfw.ContextVersionMinor, cfg.Minor)
+	if cfg.ES {
+		glfw.WindowHint(glfw.ClientAPI, glfw.OpenGLESAPI)
+	} else if cfg.Major > 3 || cfg.Major == 3 && cfg.Minor >= 2 {
+		// Profiles were introduced in OpenGL 3.2.
+		glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
+		glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
+	}
+	window, err := glfw.CreateWindow(cfg.Width, cfg.Height, cfg.Title, nil, nil)
+	if err != nil {
+		glfw.Terminate()
+		return nil, err
+	}
+	window.MakeContextCurrent()
+	if err := bindings.Init(cfg.ES, glfw.GetProcAddress); err != nil {
+		window.Destroy()
+		glfw.Terminate()
+		return nil, err
+	}
+	shaders.ClearErrors()
+	if cfg.DisableVSync {
+		glfw.SwapInterval(0)
+	} else {
+		glfw.SwapInterval(1)
+	}
+	return &App{Window: window, Renderer: shaders.NewRenderer(), cfg: cfg}, nil
+}
+
+// Run calls update and then draw once per frame, swapping buffers and polling
+// events after each, until the window is closed, Stop is called or a callback
+// returns an error, which Run returns. Either callback may be nil.
+func (a *App) Run(update, draw func(Frame) error) error {
+	if a.Window == nil {
+		return errors.New("app: Run called after Close")
+	}
+	a.stopped = false
+	var (
+		frame     Frame
+		start     = time.Now()
+		last      = start
+		fpsStart  = start
+		fpsFrames int
+	)
+	prevWidth, prevHeight := a.Window.GetFramebufferSize()
+	for !a.Window.ShouldClose() && !a.stopped {
+		now := time.Now()
+		frame.Time = now.Sub(start)
+		if frame.Count > 0 {
+			frame.Delta = now.Sub(last)
+		}
+		last = now
+		if elapsed := now.Sub(fpsStart); elapsed >= time.Second {
+			frame.FPS = float64(fpsFrames) / elapsed.Seconds()
+			fpsStart, fpsFrames = now, 0
+		}
+
+		if width, height := a.Window.GetFramebufferSize(); width != prevWidth || height != prevHeight {
+			prevWidth, prevHeight = width, height
+			a.Renderer.SetViewport(0, 0, width, height)
+		}
+		a.updateUniforms(frame, now, prevWidth, prevHeight)
+		if update != nil {
+			if err := update(frame); err != nil {
+				return err
+			}
+		}
+		if draw != nil {
+			if err := draw(frame); err != nil {
+				return err
+			}
+		}
+		a.Window.SwapBuffers()
+		glfw.PollEvents()
+		if !a.cfg.IgnoreEscape && a.Window.GetKey(glfw.KeyEscape) == glfw.Press {
+			a.Window.SetShouldClose(true)
+		}
+		frame.Count++
+		fpsFrames++
+	}
+	return nil
+}
+
+func (a *App) updateUniforms(frame Frame, now time.Time, width, height int) {
+	u := &a.Uniforms
+	u.Time = float32(frame.Time.Seconds())
+	u.Delta = float32(frame.Delta.Seconds())
+	u.Frame = int(frame.Count)
+	u.Width, u.Height = float32(width), float32(height)
+	u.Date = now
+	// Cursor positions are in screen coordinates with the origin at the top left.
+	x, y := a.Window.GetCursorPos()
+	winWidth, winHeight := a.Window.GetSize()
+	if winWidth > 0 && winHeight > 0 {
+		x *= float64(width) / float64(winWidth)
+		y *= float64(height) / float64(winHeight)
+	}
+	pressed := a.Window.GetMouseButton(glfw.MouseButtonLeft) == glfw.Press
+	u.SetMouse(float32(x), float32(height)-float32(y), pressed)
+}
+
+// Stop makes Run return after the current frame.
+func (a *App) Stop() { a.stopped = true }
+
+// Size returns the size of the window framebuffer in pixels, which
+// differs from the window size on high DPI displays.
+func (a *App) Size() (width, height int) {
+	return a.Window.GetFramebufferSize()
+}
+
+// Close destroys the window and its OpenGL context and terminates GLFW.
+func (a *App) Close() {
+	if a.Window == nil {
+		return
+	}
+	a.Window.Destroy()
+	a.Window = nil
+	glfw.Terminate()
+}
+
+func glfwBool(b bool) int {
+	if b {
+		return glfw.True
+	}
+	return glfw.False
+}
@@ -0,0 +1,26 @@
+package shaders
+
+import (
+	"github.com/soypat/shaders/backend"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+func init() {
+	gl.SetBackend(defaultBackend)
+}
+
+// SetBackend sets the OpenGL implementation the package calls into.
+// By default calls are forwarded to the go-gl OpenGL 4.6 core bindings.
+// Build with the gl33, gl41 or gles30 tag to default to the OpenGL 3.3 core,
+// 4.1 core or OpenGL ES 3.0 bindings instead, i.e: on macOS which stops at 4.1.
+// The bindings package must be initialized, i.e: with gl.Init from
+// github.com/go-gl/gl/v4.1-core/gl when building with gl41.
+// Tests may use an in-memory backend to run without an OpenGL context:
+//
+//	shaders.SetBackend(fake.New(640, 480))
+//
+// The cached version and extensions of the current context are discarded.
+func SetBackend(b backend.Backend) {
+	gl.SetBackend(b)
+	InvalidateContext()
+}
@@ -0,0 +1,129 @@
+// Package backend defines the set of OpenGL functions package shaders calls.
+//
+// Implementations forward the calls to an OpenGL binding, i.e: package gl46
+// forwards to github.com/go-gl/gl/v4.6-core/gl and packages gl33, gl41 and
+// gles30 to the OpenGL 3.3 core, 4.1 core and OpenGL ES 3.0 bindings, or emulate OpenGL in memory
+// such as package fake which makes it possible to test code that uses
+// package shaders on machines with no GPU. Method signatures follow go-gl.
+package backend
+
+import "unsafe"
+
+// Backend is the set of OpenGL functions used by package shaders.
+// Functions follow the OpenGL specification, see https://registry.khronos.org/OpenGL-Refpages/gl4/.
+type Backend interface {
+	ActiveTexture(texture uint32)
+	AttachShader(program uint32, shader uint32)
+	BindBuffer(target uint32, buffer uint32)
+	BindBufferBase(target uint32, index uint32, buffer uint32)
+	BindFragDataLocation(program uint32, color uint32, name *uint8)
+	BindFramebuffer(target uint32, framebuffer uint32)
+	BindRenderbuffer(target uint32, renderbuffer uint32)
+	BindTexture(target uint32, texture uint32)
+	BindVertexArray(array uint32)
+	BlendEquationSeparate(modeRGB uint32, modeAlpha uint32)
+	BlendEquationSeparatei(buf uint32, modeRGB uint32, modeAlpha uint32)
+	BlendFuncSeparate(sfactorRGB uint32, dfactorRGB uint32, sfactorAlpha uint32, dfactorAlpha uint32)
+	BlendFuncSeparatei(buf uint32, srcRGB uint32, dstRGB uint32, srcAlpha uint32, dstAlpha uint32)
+	BufferData(target uint32, size int, data unsafe.Pointer, usage uint32)
+	BufferSubData(target uint32, offset int, size int, data unsafe.Pointer)
+	CheckFramebufferStatus(target uint32) uint32
+	Clear(mask uint32)
+	ClearColor(red float32, green float32, blue float32, alpha float32)
+	ClearDepth(depth float64)
+	ClearStencil(s int32)
+	ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32
+	ColorMask(red bool, green bool, blue bool, alpha bool)
+	CompileShader(shader uint32)
+	CreateProgram() uint32
+	CreateShader(xtype uint32) uint32
+	CullFace(mode uint32)
+	DebugMessageCallback(callback DebugProc, userParam unsafe.Pointer)
+	DebugMessageControl(source uint32, xtype uint32, severity uint32, count int32, ids *uint32, enabled bool)
+	DeleteBuffers(n int32, buffers *uint32)
+	DeleteFramebuffers(n int32, framebuffers *uint32)
+	DeleteProgram(program uint32)
+	DeleteRenderbuffers(n int32, renderbuffers *uint32)
+	DeleteShader(shader uint32)
+	DeleteSync(sync uintptr)
+	DeleteTextures(n int32, textures *uint32)
+	DeleteVertexArrays(n int32, arrays *uint32)
+	DepthFunc(xfunc uint32)
+	DepthMask(flag bool)
+	DetachShader(program uint32, shader uint32)
+	Disable(cap uint32)
+	Disablei(target uint32, index uint32)
+	DispatchCompute(num_groups_x uint32, num_groups_y uint32, num_groups_z uint32)
+	DrawArrays(mode uint32, first int32, count int32)
+	DrawArraysInstanced(mode uint32, first int32, count int32, instancecount int32)
+	DrawArraysInstancedBaseInstance(mode uint32, first int32, count int32, instancecount int32, baseinstance uint32)
+	DrawBuffers(n int32, bufs *uint32)
+	DrawElementsInstanced(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32)
+	DrawElementsInstancedBaseInstance(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32, baseinstance uint32)
+	DrawElementsWithOffset(mode uint32, count int32, xtype uint32, indices uintptr)
+	Enable(cap uint32)
+	EnableVertexAttribArray(index uint32)
+	Enablei(target uint32, index uint32)
+	FenceSync(condition uint32, flags uint32) uintptr
+	FramebufferRenderbuffer(target uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32)
+	FramebufferTexture2D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32)
+	FrontFace(mode uint32)
+	GenBuffers(n int32, buffers *uint32)
+	GenerateMipmap(target uint32)
+	GenFramebuffers(n int32, framebuffers *uint32)
+	GenRenderbuffers(n int32, renderbuffers *uint32)
+	GenTextures(n int32, textures *uint32)
+	GenVertexArrays(n int32, arrays *uint32)
+	GetAttribLocation(program uint32, name *uint8) int32
+	GetError() uint32
+	GetIntegeri_v(target uint32, index uint32, data *int32)
+	GetIntegerv(pname uint32, data *int32)
+	GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8)
+	GetProgramiv(program uint32, pname uint32, params *int32)
+	GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8)
+	GetShaderiv(shader uint32, pname uint32, params *int32)
+	GetString(name uint32) *uint8
+	GetStringi(name uint32, index uint32) *uint8
+	GetTexImage(target uint32, level int32, format uint32, xtype uint32, pixels unsafe.Pointer)
+	GetUniformLocation(program uint32, name *uint8) int32
+	LinkProgram(program uint32)
+	MapBufferRange(target uint32, offset int, length int, access uint32) unsafe.Pointer
+	MemoryBarrier(barriers uint32)
+	MultiDrawElementsIndirect(mode uint32, xtype uint32, indirect unsafe.Pointer, drawcount int32, stride int32)
+	ObjectLabel(identifier uint32, name uint32, length int32, label *uint8)
+	PixelStorei(pname uint32, param int32)
+	PolygonMode(face uint32, mode uint32)
+	PopDebugGroup()
+	PushDebugGroup(source uint32, id uint32, length int32, message *uint8)
+	ReadBuffer(src uint32)
+	ReadPixels(x int32, y int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer)
+	RenderbufferStorage(target uint32, internalformat uint32, width int32, height int32)
+	Scissor(x int32, y int32, width int32, height int32)
+	ShaderSource(shader uint32, source string)
+	StencilFuncSeparate(face uint32, xfunc uint32, ref int32, mask uint32)
+	StencilMaskSeparate(face uint32, mask uint32)
+	StencilOpSeparate(face uint32, sfail uint32, dpfail uint32, dppass uint32)
+	TexImage2D(target uint32, level int32, internalformat int32, width int32, height int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer)
+	TexParameteri(target uint32, pname uint32, param int32)
+	Uniform1f(location int32, v0 float32)
+	Uniform1i(location int32, v0 int32)
+	Uniform2f(location int32, v0 float32, v1 float32)
+	Uniform3f(location int32, v0 float32, v1 float32, v2 float32)
+	Uniform4f(location int32, v0 float32, v1 float32, v2 float32, v3 float32)
+	UnmapBuffer(target uint32) bool
+	UseProgram(program uint32)
+	ValidateProgram(program uint32)
+	VertexAttribDivisor(index uint32, divisor uint32)
+	VertexAttribPointerWithOffset(index uint32, size int32, xtype uint32, normalized bool, stride int32, offset uintptr)
+	Viewport(x int32, y int32, width int32, height int32)
+
+	// Version returns the newest OpenGL version whose functions the backend
+	// implements and whether it is an OpenGL ES version. Package shaders does
+	// not call functions introduced after it, even if the context supports them,
+	// and instead relies on extensions. Functions missing from the bindings panic.
+	Version() (major, minor int, es bool)
+}
+
+// DebugProc is the callback type of DebugMessageCallback. It has the same
+// underlying type as go-gl's DebugProc.
+type DebugProc func(source uint32, gltype uint32, id uint32, severity uint32, length int32, message string, userParam unsafe.Pointer)
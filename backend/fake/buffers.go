@@ -0,0 +1,414 @@
+package fake
+
+import (
+	"unsafe"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// Buffer is a buffer object.
+type Buffer struct {
+	// Data is the contents of the buffer.
+	Data []byte
+	// Usage is the usage hint of the last glBufferData call, i.e: gl.STATIC_DRAW.
+	Usage  uint32
+	Label  string
+	mapped bool
+}
+
+// VertexArray is a vertex array object.
+type VertexArray struct {
+	// Attribs holds the vertex attributes configured, keyed by location.
+	Attribs map[uint32]*VertexAttrib
+	// ElementBuffer is the name of the index buffer bound to the vertex array.
+	ElementBuffer uint32
+	Label         string
+}
+
+// VertexAttrib is the configuration of a vertex attribute as set by
+// glVertexAttribPointer and glVertexAttribDivisor.
+type VertexAttrib struct {
+	Enabled bool
+	// Buffer is the name of the buffer bound to gl.ARRAY_BUFFER when the attribute was set.
+	Buffer     uint32
+	Size       int32
+	Type       uint32
+	Normalized bool
+	Stride     int32
+	Offset     uintptr
+	Divisor    uint32
+}
+
+// Draw is a draw or compute dispatch call recorded by the fake.
+type Draw struct {
+	// Func is the OpenGL function called without the gl prefix, i.e: "DrawElementsInstanced".
+	Func string
+	// Mode is the primitive mode, i.e: gl.TRIANGLES.
+	Mode uint32
+	// Objects in use at the time of the call.
+	Program, VertexArray, Framebuffer uint32
+	// ElementBuffer is the index buffer used by indexed draws.
+	ElementBuffer uint32
+	// IndirectBuffer is the buffer commands are read from by indirect draws.
+	IndirectBuffer uint32
+	// First is the first vertex of array draws or the byte offset into the
+	// index buffer for indexed draws.
+	First     int
+	Count     int32
+	Instances int32
+	// BaseInstance is the base instance of draws with base instance.
+	BaseInstance uint32
+	// DrawCount is the number of commands of multi draws.
+	DrawCount int32
+	// Groups is the number of work groups of a compute dispatch.
+	Groups [3]uint32
+	// Textures holds the texture bound to each texture unit.
+	Textures [MaxTextureUnits]uint32
+	// Viewport is the viewport of draws.
+	Viewport [4]int32
+}
+
+// Buffer returns the buffer with the given name or nil if there is no such buffer.
+func (b *Backend) Buffer(name uint32) *Buffer { return b.buffers[name] }
+
+// VertexArray returns the vertex array with the given name or nil if there is no such vertex array.
+func (b *Backend) VertexArray(name uint32) *VertexArray {
+	if name == 0 {
+		return nil
+	}
+	return b.vertexArrays[name]
+}
+
+func isBufferTarget(target uint32) bool {
+	switch target {
+	case gl.ARRAY_BUFFER, gl.ELEMENT_ARRAY_BUFFER, gl.PIXEL_PACK_BUFFER, gl.PIXEL_UNPACK_BUFFER,
+		gl.DRAW_INDIRECT_BUFFER, gl.DISPATCH_INDIRECT_BUFFER, gl.SHADER_STORAGE_BUFFER,
+		gl.UNIFORM_BUFFER, gl.COPY_READ_BUFFER, gl.COPY_WRITE_BUFFER, gl.TEXTURE_BUFFER,
+		gl.ATOMIC_COUNTER_BUFFER, gl.QUERY_BUFFER, gl.TRANSFORM_FEEDBACK_BUFFER:
+		return true
+	}
+	return false
+}
+
+func (b *Backend) GenBuffers(n int32, buffers *uint32) {
+	b.genNames(nsBuffer, n, buffers, func(name uint32) {
+		b.buffers[name] = &Buffer{}
+	})
+}
+
+func (b *Backend) DeleteBuffers(n int32, buffers *uint32) {
+	for _, name := range unsafe.Slice(buffers, n) {
+		if name == 0 || b.buffers[name] == nil {
+			continue // Unused names are silently ignored.
+		}
+		delete(b.buffers, name)
+		// Deleted buffers are unbound from the context and the current vertex array.
+		for target, bound := range b.bufferBindings {
+			if bound == name {
+				delete(b.bufferBindings, target)
+			}
+		}
+		for key, bound := range b.indexedBindings {
+			if bound == name {
+				delete(b.indexedBindings, key)
+			}
+		}
+		if vao := b.vertexArrays[b.vertexArray]; vao.ElementBuffer == name {
+			vao.ElementBuffer = 0
+		}
+	}
+}
+
+func (b *Backend) BindBuffer(target uint32, buffer uint32) {
+	if !isBufferTarget(target) {
+		b.setError(gl.INVALID_ENUM, "glBindBuffer: invalid target 0x%x", target)
+		return
+	}
+	if buffer != 0 && b.buffers[buffer] == nil {
+		b.setError(gl.INVALID_VALUE, "glBindBuffer: %d is not a name returned by glGenBuffers", buffer)
+		return
+	}
+	if target == gl.ELEMENT_ARRAY_BUFFER {
+		b.vertexArrays[b.vertexArray].ElementBuffer = buffer
+		return
+	}
+	b.bufferBindings[target] = buffer
+}
+
+func (b *Backend) BindBufferBase(target uint32, index uint32, buffer uint32) {
+	switch target {
+	case gl.SHADER_STORAGE_BUFFER, gl.UNIFORM_BUFFER, gl.ATOMIC_COUNTER_BUFFER, gl.TRANSFORM_FEEDBACK_BUFFER:
+	default:
+		b.setError(gl.INVALID_ENUM, "glBindBufferBase: invalid target 0x%x", target)
+		return
+	}
+	if buffer != 0 && b.buffers[buffer] == nil {
+		b.setError(gl.INVALID_VALUE, "glBindBufferBase: %d is not a name returned by glGenBuffers", buffer)
+		return
+	}
+	// Binding to an indexed binding point also binds to the generic binding point.
+	b.indexedBindings[[2]uint32{target, index}] = buffer
+	b.bufferBindings[target] = buffer
+}
+
+// BoundBase returns the name of the buffer bound to index of an indexed
+// buffer target such as gl.SHADER_STORAGE_BUFFER.
+func (b *Backend) BoundBase(target, index uint32) uint32 {
+	return b.indexedBindings[[2]uint32{target, index}]
+}
+
+// boundBuffer returns the buffer bound to target or sets an error if there is none.
+func (b *Backend) boundBuffer(fn string, target uint32) *Buffer {
+	if !isBufferTarget(target) {
+		b.setError(gl.INVALID_ENUM, "%s: invalid target 0x%x", fn, target)
+		return nil
+	}
+	buf := b.buffers[b.Bound(target)]
+	if buf == nil {
+		b.setError(gl.INVALID_OPERATION, "%s: no buffer bound to target 0x%x", fn, target)
+	}
+	return buf
+}
+
+func (b *Backend) BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	buf := b.boundBuffer("glBufferData", target)
+	if buf == nil {
+		return
+	}
+	if size < 0 {
+		b.setError(gl.INVALID_VALUE, "glBufferData: negative size")
+		return
+	}
+	if buf.mapped {
+		b.setError(gl.INVALID_OPERATION, "glBufferData: buffer is mapped")
+		return
+	}
+	buf.Data = make([]byte, size)
+	if data != nil {
+		copy(buf.Data, unsafe.Slice((*byte)(data), size))
+	}
+	buf.Usage = usage
+}
+
+func (b *Backend) BufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	buf := b.boundBuffer("glBufferSubData", target)
+	if buf == nil {
+		return
+	}
+	if offset < 0 || size < 0 || offset+size > len(buf.Data) {
+		b.setError(gl.INVALID_VALUE, "glBufferSubData: range [%d,%d) out of buffer of size %d", offset, offset+size, len(buf.Data))
+		return
+	}
+	if buf.mapped {
+		b.setError(gl.INVALID_OPERATION, "glBufferSubData: buffer is mapped")
+		return
+	}
+	copy(buf.Data[offset:], unsafe.Slice((*byte)(data), size))
+}
+
+func (b *Backend) MapBufferRange(target uint32, offset int, length int, access uint32) unsafe.Pointer {
+	buf := b.boundBuffer("glMapBufferRange", target)
+	if buf == nil {
+		return nil
+	}
+	if offset < 0 || length <= 0 || offset+length > len(buf.Data) {
+		b.setError(gl.INVALID_VALUE, "glMapBufferRange: range [%d,%d) out of buffer of size %d", offset, offset+length, len(buf.Data))
+		return nil
+	}
+	if buf.mapped {
+		b.setError(gl.INVALID_OPERATION, "glMapBufferRange: buffer already mapped")
+		return nil
+	}
+	buf.mapped = true
+	return unsafe.Pointer(&buf.Data[offset])
+}
+
+func (b *Backend) UnmapBuffer(target uint32) bool {
+	buf := b.boundBuffer("glUnmapBuffer", target)
+	if buf == nil {
+		return false
+	}
+	if !buf.mapped {
+		b.setError(gl.INVALID_OPERATION, "glUnmapBuffer: buffer is not mapped")
+		return false
+	}
+	buf.mapped = false
+	return true
+}
+
+func (b *Backend) GenVertexArrays(n int32, arrays *uint32) {
+	b.genNames(nsVertexArray, n, arrays, func(name uint32) {
+		b.vertexArrays[name] = &VertexArray{Attribs: make(map[uint32]*VertexAttrib)}
+	})
+}
+
+func (b *Backend) DeleteVertexArrays(n int32, arrays *uint32) {
+	for _, name := range unsafe.Slice(arrays, n) {
+		if name == 0 || b.vertexArrays[name] == nil {
+			continue
+		}
+		delete(b.vertexArrays, name)
+		if b.vertexArray == name {
+			b.vertexArray = 0
+		}
+	}
+}
+
+func (b *Backend) BindVertexArray(array uint32) {
+	if b.vertexArrays[array] == nil {
+		b.setError(gl.INVALID_OPERATION, "glBindVertexArray: %d is not a name returned by glGenVertexArrays", array)
+		return
+	}
+	b.vertexArray = array
+}
+
+// vertexAttrib returns the attribute at index of the bound
+// vertex array or sets an error if there is no vertex array bound.
+func (b *Backend) vertexAttrib(fn string, index uint32) *VertexAttrib {
+	if b.vertexArray == 0 {
+		b.setError(gl.INVALID_OPERATION, "%s: no vertex array bound", fn)
+		return nil
+	}
+	if index >= MaxVertexAttribs {
+		b.setError(gl.INVALID_VALUE, "%s: index %d exceeds GL_MAX_VERTEX_ATTRIBS", fn, index)
+		return nil
+	}
+	vao := b.vertexArrays[b.vertexArray]
+	attr := vao.Attribs[index]
+	if attr == nil {
+		attr = &VertexAttrib{Size: 4, Type: gl.FLOAT}
+		vao.Attribs[index] = attr
+	}
+	return attr
+}
+
+func (b *Backend) EnableVertexAttribArray(index uint32) {
+	if attr := b.vertexAttrib("glEnableVertexAttribArray", index); attr != nil {
+		attr.Enabled = true
+	}
+}
+
+func (b *Backend) VertexAttribPointerWithOffset(index uint32, size int32, xtype uint32, normalized bool, stride int32, offset uintptr) {
+	const fn = "glVertexAttribPointer"
+	attr := b.vertexAttrib(fn, index)
+	if attr == nil {
+		return
+	}
+	if size < 1 || size > 4 {
+		b.setError(gl.INVALID_VALUE, "%s: size %d not in range [1,4]", fn, size)
+		return
+	}
+	if stride < 0 {
+		b.setError(gl.INVALID_VALUE, "%s: negative stride", fn)
+		return
+	}
+	switch xtype {
+	case gl.BYTE, gl.UNSIGNED_BYTE, gl.SHORT, gl.UNSIGNED_SHORT, gl.INT, gl.UNSIGNED_INT,
+		gl.HALF_FLOAT, gl.FLOAT, gl.DOUBLE, gl.FIXED:
+	default:
+		b.setError(gl.INVALID_ENUM, "%s: invalid type 0x%x", fn, xtype)
+		return
+	}
+	buffer := b.bufferBindings[gl.ARRAY_BUFFER]
+	if buffer == 0 && offset != 0 {
+		b.setError(gl.INVALID_OPERATION, "%s: no buffer bound to GL_ARRAY_BUFFER", fn)
+		return
+	}
+	attr.Buffer, attr.Size, attr.Type, attr.Normalized, attr.Stride, attr.Offset = buffer, size, xtype, normalized, stride, offset
+}
+
+func (b *Backend) VertexAttribDivisor(index uint32, divisor uint32) {
+	if attr := b.vertexAttrib("glVertexAttribDivisor", index); attr != nil {
+		attr.Divisor = divisor
+	}
+}
+
+// draw validates the context state for a draw call and records it.
+func (b *Backend) draw(d Draw, indexed bool) {
+	fn := "gl" + d.Func
+	prog := b.programs[b.programInUse]
+	if prog == nil {
+		b.setError(gl.INVALID_OPERATION, "%s: no program in use", fn)
+		return
+	}
+	if prog.Compute {
+		b.setError(gl.INVALID_OPERATION, "%s: program in use is a compute program", fn)
+		return
+	}
+	if b.vertexArray == 0 {
+		b.setError(gl.INVALID_OPERATION, "%s: no vertex array bound", fn)
+		return
+	}
+	if d.Count < 0 || d.Instances < 0 || d.DrawCount < 0 {
+		b.setError(gl.INVALID_VALUE, "%s: negative count", fn)
+		return
+	}
+	if indexed {
+		d.ElementBuffer = b.vertexArrays[b.vertexArray].ElementBuffer
+		if d.ElementBuffer == 0 {
+			b.setError(gl.INVALID_OPERATION, "%s: no index buffer bound to vertex array", fn)
+			return
+		}
+	}
+	for loc, attr := range b.vertexArrays[b.vertexArray].Attribs {
+		if attr.Enabled && b.buffers[attr.Buffer] == nil {
+			b.setError(gl.INVALID_OPERATION, "%s: attribute %d reads from deleted buffer %d", fn, loc, attr.Buffer)
+			return
+		}
+	}
+	d.Program = b.programInUse
+	d.VertexArray = b.vertexArray
+	d.Framebuffer = b.drawFramebuffer
+	d.Textures = b.textureUnits
+	d.Viewport = b.State.Viewport
+	b.Draws = append(b.Draws, d)
+}
+
+func (b *Backend) DrawArrays(mode uint32, first int32, count int32) {
+	b.draw(Draw{Func: "DrawArrays", Mode: mode, First: int(first), Count: count, Instances: 1}, false)
+}
+
+func (b *Backend) DrawArraysInstanced(mode uint32, first int32, count int32, instancecount int32) {
+	b.draw(Draw{Func: "DrawArraysInstanced", Mode: mode, First: int(first), Count: count, Instances: instancecount}, false)
+}
+
+func (b *Backend) DrawArraysInstancedBaseInstance(mode uint32, first int32, count int32, instancecount int32, baseinstance uint32) {
+	b.draw(Draw{Func: "DrawArraysInstancedBaseInstance", Mode: mode, First: int(first), Count: count, Instances: instancecount, BaseInstance: baseinstance}, false)
+}
+
+func (b *Backend) DrawElementsWithOffset(mode uint32, count int32, xtype uint32, indices uintptr) {
+	b.draw(Draw{Func: "DrawElements", Mode: mode, First: int(indices), Count: count, Instances: 1}, true)
+}
+
+func (b *Backend) DrawElementsInstanced(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32) {
+	b.draw(Draw{Func: "DrawElementsInstanced", Mode: mode, First: int(uintptr(indices)), Count: count, Instances: instancecount}, true)
+}
+
+func (b *Backend) DrawElementsInstancedBaseInstance(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32, baseinstance uint32) {
+	b.draw(Draw{Func: "DrawElementsInstancedBaseInstance", Mode: mode, First: int(uintptr(indices)), Count: count, Instances: instancecount, BaseInstance: baseinstance}, true)
+}
+
+func (b *Backend) MultiDrawElementsIndirect(mode uint32, xtype uint32, indirect unsafe.Pointer, drawcount int32, stride int32) {
+	cmds := b.bufferBindings[gl.DRAW_INDIRECT_BUFFER]
+	if cmds == 0 {
+		b.setError(gl.INVALID_OPERATION, "glMultiDrawElementsIndirect: no buffer bound to GL_DRAW_INDIRECT_BUFFER")
+		return
+	}
+	b.draw(Draw{Func: "MultiDrawElementsIndirect", Mode: mode, First: int(uintptr(indirect)), IndirectBuffer: cmds, DrawCount: drawcount}, true)
+}
+
+func (b *Backend) DispatchCompute(num_groups_x uint32, num_groups_y uint32, num_groups_z uint32) {
+	prog := b.programs[b.programInUse]
+	if prog == nil || !prog.Compute {
+		b.setError(gl.INVALID_OPERATION, "glDispatchCompute: no compute program in use")
+		return
+	}
+	b.Draws = append(b.Draws, Draw{
+		Func:        "DispatchCompute",
+		Program:     b.programInUse,
+		VertexArray: b.vertexArray,
+		Framebuffer: b.drawFramebuffer,
+		Groups:      [3]uint32{num_groups_x, num_groups_y, num_groups_z},
+		Textures:    b.textureUnits,
+	})
+}
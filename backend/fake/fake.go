@@ -0,0 +1,676 @@
+// Package fake implements backend.Backend in memory so that code using
+// package shaders can be tested with plain go test on machines with no GPU
+// or display. It keeps track of objects, bindings, uploaded data and pipeline
+// state and sets the OpenGL error flags a driver would for common misuse,
+// i.e: binding deleted objects or drawing with no program in use.
+//
+// Nothing is rasterized. Draw calls are recorded in Backend.Draws and
+// only clears write to framebuffer attachments.
+//
+//	b := fake.New(640, 480)
+//	shaders.SetBackend(b)
+//	vbo, err := shaders.NewVertexBuffer(vertices)
+//	// ...
+//	data := b.Buffer(b.Bound(gl.ARRAY_BUFFER)).Data
+package fake
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unsafe"
+
+	"github.com/soypat/shaders/backend"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// Implementation limits reported by the fake.
+const (
+	MaxVertexAttribs      = 16
+	MaxDrawBuffers        = 8
+	MaxTextureUnits       = 32
+	MaxTextureSize        = 16384
+	MaxUniformBlockSize   = 65536
+	MaxBufferBindings     = 16
+	MaxComputeWorkGroups  = 65535
+	MaxComputeLocalSize   = 1024
+	MaxComputeLocalSizeZ  = 64
+	MaxUniformComponents  = 4096
+	MaxSamples            = 8
+	MaxStorageBlockSize   = 1 << 27
+	MaxComputeInvocations = 1024
+)
+
+// Backend is an in-memory OpenGL implementation. The zero value is not
+// usable, create one with New.
+type Backend struct {
+	// Major and Minor is the OpenGL version reported by the fake.
+	Major, Minor int32
+	// ES makes the fake report an OpenGL ES context of version Major.Minor.
+	ES bool
+	// Extensions reported by the fake, i.e: "GL_KHR_debug".
+	Extensions []string
+	// Draws records every draw and compute dispatch in order.
+	Draws []Draw
+	// State is the pipeline state set by the last calls.
+	State State
+
+	errs []uint32
+	// Next name to hand out for each namespace. Shaders and programs share names.
+	nextName map[namespace]uint32
+
+	buffers       map[uint32]*Buffer
+	vertexArrays  map[uint32]*VertexArray
+	shaders       map[uint32]*Shader
+	programs      map[uint32]*Program
+	textures      map[uint32]*Texture
+	framebuffers  map[uint32]*Framebuffer
+	renderbuffers map[uint32]*Renderbuffer
+	fences        map[uintptr]bool
+	nextFence     uintptr
+
+	// Bindings.
+	bufferBindings  map[uint32]uint32
+	indexedBindings map[[2]uint32]uint32
+	textureUnits    [MaxTextureUnits]uint32
+	activeUnit      uint32
+	vertexArray     uint32
+	programInUse    uint32
+	drawFramebuffer uint32
+	readFramebuffer uint32
+	renderbuffer    uint32
+
+	caps        map[uint32]bool
+	indexedCaps map[[2]uint32]bool
+	pixelStore  map[uint32]int32
+
+	debugCallback backend.DebugProc
+	debugGroups   []string
+	// cstrings keeps strings returned by GetStringi alive.
+	cstrings map[string][]byte
+}
+
+var _ backend.Backend = (*Backend)(nil)
+
+// State is the fixed function pipeline state of the fake.
+type State struct {
+	ClearColor   [4]float32
+	ClearDepth   float64
+	ClearStencil int32
+	Viewport     [4]int32
+	Scissor      [4]int32
+	ColorMask    [4]bool
+	DepthMask    bool
+	DepthFunc    uint32
+	CullFace     uint32
+	FrontFace    uint32
+	PolygonMode  uint32
+	Blend        [MaxDrawBuffers]Blend
+	StencilFront StencilFace
+	StencilBack  StencilFace
+}
+
+// Blend is the blend equation and function of a draw buffer.
+type Blend struct {
+	EquationRGB, EquationAlpha         uint32
+	SrcRGB, DstRGB, SrcAlpha, DstAlpha uint32
+}
+
+// StencilFace is the stencil state of front or back facing polygons.
+type StencilFace struct {
+	Func                  uint32
+	Ref                   int32
+	ReadMask, WriteMask   uint32
+	Fail, DepthFail, Pass uint32
+}
+
+type namespace uint8
+
+const (
+	nsBuffer namespace = iota
+	nsVertexArray
+	nsShaderProgram
+	nsTexture
+	nsFramebuffer
+	nsRenderbuffer
+)
+
+// New returns a fake OpenGL 4.6 context whose default framebuffer
+// is width*height pixels. Like a real context the viewport and scissor
+// box are initialized to cover the default framebuffer.
+func New(width, height int) *Backend {
+	b := &Backend{
+		Major:           4,
+		Minor:           6,
+		nextName:        make(map[namespace]uint32),
+		buffers:         make(map[uint32]*Buffer),
+		vertexArrays:    make(map[uint32]*VertexArray),
+		shaders:         make(map[uint32]*Shader),
+		programs:        make(map[uint32]*Program),
+		textures:        make(map[uint32]*Texture),
+		framebuffers:    make(map[uint32]*Framebuffer),
+		renderbuffers:   make(map[uint32]*Renderbuffer),
+		fences:          make(map[uintptr]bool),
+		bufferBindings:  make(map[uint32]uint32),
+		indexedBindings: make(map[[2]uint32]uint32),
+		caps:            make(map[uint32]bool),
+		indexedCaps:     make(map[[2]uint32]bool),
+		pixelStore:      map[uint32]int32{gl.PACK_ALIGNMENT: 4, gl.UNPACK_ALIGNMENT: 4},
+		cstrings:        make(map[string][]byte),
+	}
+	rect := [4]int32{0, 0, int32(width), int32(height)}
+	b.State = State{
+		ClearDepth:  1,
+		Viewport:    rect,
+		Scissor:     rect,
+		ColorMask:   [4]bool{true, true, true, true},
+		DepthMask:   true,
+		DepthFunc:   gl.LESS,
+		CullFace:    gl.BACK,
+		FrontFace:   gl.CCW,
+		PolygonMode: gl.FILL,
+	}
+	for i := range b.State.Blend {
+		b.State.Blend[i] = Blend{
+			EquationRGB: gl.FUNC_ADD, EquationAlpha: gl.FUNC_ADD,
+			SrcRGB: gl.ONE, DstRGB: gl.ZERO, SrcAlpha: gl.ONE, DstAlpha: gl.ZERO,
+		}
+	}
+	for _, sf := range []*StencilFace{&b.State.StencilFront, &b.State.StencilBack} {
+		*sf = StencilFace{Func: gl.ALWAYS, ReadMask: ^uint32(0), WriteMask: ^uint32(0), Fail: gl.KEEP, DepthFail: gl.KEEP, Pass: gl.KEEP}
+	}
+	// The default framebuffer is framebuffer 0 with a single color buffer.
+	b.framebuffers[0] = &Framebuffer{
+		Attachments: make(map[uint32]Attachment),
+		DrawBuffers: []uint32{gl.BACK},
+		ReadBuffer:  gl.BACK,
+		backbuffer:  newTexture(int32(width), int32(height), gl.RGBA8),
+	}
+	b.vertexArrays[0] = &VertexArray{Attribs: make(map[uint32]*VertexAttrib)}
+	return b
+}
+
+// setError records an OpenGL error flag and reports it to the debug
+// callback if debug output is enabled, like drivers do.
+func (b *Backend) setError(code uint32, format string, args ...any) {
+	for _, e := range b.errs {
+		if e == code {
+			return
+		}
+	}
+	b.errs = append(b.errs, code)
+	if b.debugCallback != nil && b.caps[gl.DEBUG_OUTPUT] {
+		msg := fmt.Sprintf(format, args...)
+		b.debugCallback(gl.DEBUG_SOURCE_API, gl.DEBUG_TYPE_ERROR, code, gl.DEBUG_SEVERITY_HIGH, int32(len(msg)), msg, nil)
+	}
+}
+
+// InjectError sets the error flag code as if the last call had failed.
+// It is useful to test error handling paths.
+func (b *Backend) InjectError(code uint32) {
+	b.setError(code, "injected error")
+}
+
+func (b *Backend) GetError() uint32 {
+	if len(b.errs) == 0 {
+		return gl.NO_ERROR
+	}
+	code := b.errs[0]
+	b.errs = b.errs[1:]
+	return code
+}
+
+// genNames implements the glGen* functions.
+func (b *Backend) genNames(ns namespace, n int32, names *uint32, create func(name uint32)) {
+	if n < 0 {
+		b.setError(gl.INVALID_VALUE, "negative count of names to generate")
+		return
+	}
+	dst := unsafe.Slice(names, n)
+	for i := range dst {
+		b.nextName[ns]++
+		dst[i] = b.nextName[ns]
+		create(dst[i])
+	}
+}
+
+// Objects returns the number of objects alive, excluding
+// the default framebuffer and vertex array. Tests use it to check for leaks.
+func (b *Backend) Objects() int {
+	return len(b.buffers) + len(b.vertexArrays) - 1 + len(b.shaders) + len(b.programs) +
+		len(b.textures) + len(b.framebuffers) - 1 + len(b.renderbuffers)
+}
+
+// Bound returns the name of the object bound to target which may be a buffer target,
+// gl.TEXTURE_2D for the active texture unit, a framebuffer target,
+// gl.RENDERBUFFER, gl.VERTEX_ARRAY or gl.PROGRAM for the program in use.
+func (b *Backend) Bound(target uint32) uint32 {
+	switch target {
+	case gl.ELEMENT_ARRAY_BUFFER:
+		return b.vertexArrays[b.vertexArray].ElementBuffer
+	case gl.TEXTURE_2D:
+		return b.textureUnits[b.activeUnit]
+	case gl.FRAMEBUFFER, gl.DRAW_FRAMEBUFFER:
+		return b.drawFramebuffer
+	case gl.READ_FRAMEBUFFER:
+		return b.readFramebuffer
+	case gl.RENDERBUFFER:
+		return b.renderbuffer
+	case gl.VERTEX_ARRAY:
+		return b.vertexArray
+	case gl.PROGRAM:
+		return b.programInUse
+	}
+	return b.bufferBindings[target]
+}
+
+// Enabled reports whether capability cap is enabled, i.e: gl.BLEND.
+func (b *Backend) Enabled(cap uint32) bool { return b.caps[cap] }
+
+// Enabledi reports whether capability cap is enabled for index i, i.e: gl.BLEND on draw buffer i.
+func (b *Backend) Enabledi(cap, i uint32) bool {
+	if enabled, ok := b.indexedCaps[[2]uint32{cap, i}]; ok {
+		return enabled
+	}
+	return b.caps[cap]
+}
+
+// DebugGroups returns the stack of debug groups pushed.
+func (b *Backend) DebugGroups() []string { return b.debugGroups }
+
+func (b *Backend) GetIntegerv(pname uint32, data *int32) {
+	switch pname {
+	case gl.VIEWPORT:
+		copy(unsafe.Slice(data, 4), b.State.Viewport[:])
+		return
+	case gl.SCISSOR_BOX:
+		copy(unsafe.Slice(data, 4), b.State.Scissor[:])
+		return
+	case gl.MAX_VIEWPORT_DIMS:
+		copy(unsafe.Slice(data, 2), []int32{MaxTextureSize, MaxTextureSize})
+		return
+	case gl.COLOR_WRITEMASK:
+		for i, m := range b.State.ColorMask {
+			unsafe.Slice(data, 4)[i] = glBool(m)
+		}
+		return
+	}
+	var v int32
+	switch pname {
+	case gl.MAJOR_VERSION:
+		v = b.Major
+	case gl.MINOR_VERSION:
+		v = b.Minor
+	case gl.NUM_EXTENSIONS:
+		v = int32(len(b.Extensions))
+	case gl.MAX_VERTEX_ATTRIBS:
+		v = MaxVertexAttribs
+	case gl.MAX_DRAW_BUFFERS, gl.MAX_COLOR_ATTACHMENTS:
+		v = MaxDrawBuffers
+	case gl.MAX_COMBINED_TEXTURE_IMAGE_UNITS:
+		v = MaxTextureUnits
+	case gl.MAX_TEXTURE_IMAGE_UNITS:
+		v = MaxTextureUnits / 2
+	case gl.MAX_TEXTURE_SIZE, gl.MAX_RENDERBUFFER_SIZE:
+		v = MaxTextureSize
+	case gl.MAX_SAMPLES:
+		v = MaxSamples
+	case gl.MAX_UNIFORM_BLOCK_SIZE:
+		v = MaxUniformBlockSize
+	case gl.MAX_UNIFORM_BUFFER_BINDINGS, gl.MAX_SHADER_STORAGE_BUFFER_BINDINGS:
+		v = MaxBufferBindings
+	case gl.MAX_VERTEX_UNIFORM_COMPONENTS, gl.MAX_FRAGMENT_UNIFORM_COMPONENTS:
+		v = MaxUniformComponents
+	case gl.MAX_SHADER_STORAGE_BLOCK_SIZE:
+		v = MaxStorageBlockSize
+	case gl.MAX_COMPUTE_WORK_GROUP_INVOCATIONS:
+		v = MaxComputeInvocations
+	case gl.ARRAY_BUFFER_BINDING:
+		v = int32(b.Bound(gl.ARRAY_BUFFER))
+	case gl.ELEMENT_ARRAY_BUFFER_BINDING:
+		v = int32(b.Bound(gl.ELEMENT_ARRAY_BUFFER))
+	case gl.VERTEX_ARRAY_BINDING:
+		v = int32(b.vertexArray)
+	case gl.CURRENT_PROGRAM:
+		v = int32(b.programInUse)
+	case gl.TEXTURE_BINDING_2D:
+		v = int32(b.textureUnits[b.activeUnit])
+	case gl.ACTIVE_TEXTURE:
+		v = int32(gl.TEXTURE0 + b.activeUnit)
+	case gl.DRAW_FRAMEBUFFER_BINDING:
+		v = int32(b.drawFramebuffer)
+	case gl.READ_FRAMEBUFFER_BINDING:
+		v = int32(b.readFramebuffer)
+	case gl.RENDERBUFFER_BINDING:
+		v = int32(b.renderbuffer)
+	case gl.PACK_ALIGNMENT, gl.UNPACK_ALIGNMENT:
+		v = b.pixelStore[pname]
+	case gl.SCISSOR_TEST:
+		v = glBool(b.caps[gl.SCISSOR_TEST])
+	case gl.DEPTH_WRITEMASK:
+		v = glBool(b.State.DepthMask)
+	case gl.STENCIL_WRITEMASK:
+		v = int32(b.State.StencilFront.WriteMask)
+	case gl.STENCIL_BACK_WRITEMASK:
+		v = int32(b.State.StencilBack.WriteMask)
+	default:
+		b.setError(gl.INVALID_ENUM, "glGetIntegerv: unsupported parameter 0x%x", pname)
+		return
+	}
+	*data = v
+}
+
+func (b *Backend) GetString(name uint32) *uint8 {
+	var s string
+	switch name {
+	case gl.VENDOR:
+		s = "github.com/soypat/shaders"
+	case gl.RENDERER:
+		s = "fake"
+	case gl.VERSION:
+		s = fmt.Sprintf("%d.%d fake", b.Major, b.Minor)
+		if b.ES {
+			s = "OpenGL ES " + s
+		}
+	case gl.SHADING_LANGUAGE_VERSION:
+		s = fmt.Sprintf("%d.%d0", b.Major, b.Minor)
+		if b.ES {
+			s = "OpenGL ES GLSL ES " + s
+		}
+	default:
+		b.setError(gl.INVALID_ENUM, "glGetString: invalid name 0x%x", name)
+		return nil
+	}
+	return b.cstring(s)
+}
+
+// Version returns the version of the context emulated by the fake.
+func (b *Backend) Version() (major, minor int, es bool) {
+	return int(b.Major), int(b.Minor), b.ES
+}
+
+func (b *Backend) GetIntegeri_v(target uint32, index uint32, data *int32) {
+	if index > 2 && (target == gl.MAX_COMPUTE_WORK_GROUP_COUNT || target == gl.MAX_COMPUTE_WORK_GROUP_SIZE) {
+		b.setError(gl.INVALID_VALUE, "glGetIntegeri_v: index %d out of range", index)
+		return
+	}
+	switch target {
+	case gl.MAX_COMPUTE_WORK_GROUP_COUNT:
+		*data = MaxComputeWorkGroups
+	case gl.MAX_COMPUTE_WORK_GROUP_SIZE:
+		*data = MaxComputeLocalSize
+		if index == 2 {
+			*data = MaxComputeLocalSizeZ
+		}
+	case gl.UNIFORM_BUFFER_BINDING, gl.SHADER_STORAGE_BUFFER_BINDING:
+		bufTarget := uint32(gl.UNIFORM_BUFFER)
+		if target == gl.SHADER_STORAGE_BUFFER_BINDING {
+			bufTarget = gl.SHADER_STORAGE_BUFFER
+		}
+		*data = int32(b.BoundBase(bufTarget, index))
+	default:
+		b.setError(gl.INVALID_ENUM, "glGetIntegeri_v: unsupported target 0x%x", target)
+	}
+}
+
+func (b *Backend) GetStringi(name uint32, index uint32) *uint8 {
+	if name != gl.EXTENSIONS {
+		b.setError(gl.INVALID_ENUM, "glGetStringi: invalid name 0x%x", name)
+		return nil
+	}
+	if int(index) >= len(b.Extensions) {
+		b.setError(gl.INVALID_VALUE, "glGetStringi: index %d out of range", index)
+		return nil
+	}
+	return b.cstring(b.Extensions[index])
+}
+
+func (b *Backend) cstring(s string) *uint8 {
+	cs, ok := b.cstrings[s]
+	if !ok {
+		cs = append([]byte(s), 0)
+		b.cstrings[s] = cs
+	}
+	return &cs[0]
+}
+
+func (b *Backend) PixelStorei(pname uint32, param int32) {
+	if pname != gl.PACK_ALIGNMENT && pname != gl.UNPACK_ALIGNMENT {
+		b.setError(gl.INVALID_ENUM, "glPixelStorei: unsupported parameter 0x%x", pname)
+		return
+	}
+	switch param {
+	case 1, 2, 4, 8:
+		b.pixelStore[pname] = param
+	default:
+		b.setError(gl.INVALID_VALUE, "glPixelStorei: invalid alignment %d", param)
+	}
+}
+
+func (b *Backend) Enable(cap uint32)  { b.setCapability(cap, true) }
+func (b *Backend) Disable(cap uint32) { b.setCapability(cap, false) }
+
+func (b *Backend) setCapability(cap uint32, enabled bool) {
+	b.caps[cap] = enabled
+	for key := range b.indexedCaps {
+		if key[0] == cap {
+			delete(b.indexedCaps, key)
+		}
+	}
+}
+
+func (b *Backend) Enablei(target uint32, index uint32)  { b.setCapabilityi(target, index, true) }
+func (b *Backend) Disablei(target uint32, index uint32) { b.setCapabilityi(target, index, false) }
+
+func (b *Backend) setCapabilityi(target, index uint32, enabled bool) {
+	if index >= MaxDrawBuffers {
+		b.setError(gl.INVALID_VALUE, "indexed capability index %d out of range", index)
+		return
+	}
+	b.indexedCaps[[2]uint32{target, index}] = enabled
+}
+
+func (b *Backend) BlendEquationSeparate(modeRGB uint32, modeAlpha uint32) {
+	for i := range b.State.Blend {
+		b.BlendEquationSeparatei(uint32(i), modeRGB, modeAlpha)
+	}
+}
+
+func (b *Backend) BlendEquationSeparatei(buf uint32, modeRGB uint32, modeAlpha uint32) {
+	if buf >= MaxDrawBuffers {
+		b.setError(gl.INVALID_VALUE, "glBlendEquationSeparatei: draw buffer %d out of range", buf)
+		return
+	}
+	b.State.Blend[buf].EquationRGB = modeRGB
+	b.State.Blend[buf].EquationAlpha = modeAlpha
+}
+
+func (b *Backend) BlendFuncSeparate(sfactorRGB uint32, dfactorRGB uint32, sfactorAlpha uint32, dfactorAlpha uint32) {
+	for i := range b.State.Blend {
+		b.BlendFuncSeparatei(uint32(i), sfactorRGB, dfactorRGB, sfactorAlpha, dfactorAlpha)
+	}
+}
+
+func (b *Backend) BlendFuncSeparatei(buf uint32, srcRGB uint32, dstRGB uint32, srcAlpha uint32, dstAlpha uint32) {
+	if buf >= MaxDrawBuffers {
+		b.setError(gl.INVALID_VALUE, "glBlendFuncSeparatei: draw buffer %d out of range", buf)
+		return
+	}
+	blend := &b.State.Blend[buf]
+	blend.SrcRGB, blend.DstRGB, blend.SrcAlpha, blend.DstAlpha = srcRGB, dstRGB, srcAlpha, dstAlpha
+}
+
+func (b *Backend) ClearColor(red float32, green float32, blue float32, alpha float32) {
+	b.State.ClearColor = [4]float32{red, green, blue, alpha}
+}
+func (b *Backend) ClearDepth(depth float64) { b.State.ClearDepth = depth }
+func (b *Backend) ClearStencil(s int32)     { b.State.ClearStencil = s }
+func (b *Backend) ColorMask(red bool, green bool, blue bool, alpha bool) {
+	b.State.ColorMask = [4]bool{red, green, blue, alpha}
+}
+func (b *Backend) DepthFunc(xfunc uint32) { b.State.DepthFunc = xfunc }
+func (b *Backend) DepthMask(flag bool)    { b.State.DepthMask = flag }
+func (b *Backend) CullFace(mode uint32)   { b.State.CullFace = mode }
+func (b *Backend) FrontFace(mode uint32)  { b.State.FrontFace = mode }
+
+func (b *Backend) PolygonMode(face uint32, mode uint32) {
+	if face != gl.FRONT_AND_BACK {
+		b.setError(gl.INVALID_ENUM, "glPolygonMode: face must be GL_FRONT_AND_BACK in core profile")
+		return
+	}
+	b.State.PolygonMode = mode
+}
+
+func (b *Backend) Viewport(x int32, y int32, width int32, height int32) {
+	if width < 0 || height < 0 {
+		b.setError(gl.INVALID_VALUE, "glViewport: negative dimensions")
+		return
+	}
+	b.State.Viewport = [4]int32{x, y, width, height}
+}
+
+func (b *Backend) Scissor(x int32, y int32, width int32, height int32) {
+	if width < 0 || height < 0 {
+		b.setError(gl.INVALID_VALUE, "glScissor: negative dimensions")
+		return
+	}
+	b.State.Scissor = [4]int32{x, y, width, height}
+}
+
+func (b *Backend) StencilFuncSeparate(face uint32, xfunc uint32, ref int32, mask uint32) {
+	for _, sf := range b.stencilFaces(face) {
+		sf.Func, sf.Ref, sf.ReadMask = xfunc, ref, mask
+	}
+}
+
+func (b *Backend) StencilMaskSeparate(face uint32, mask uint32) {
+	for _, sf := range b.stencilFaces(face) {
+		sf.WriteMask = mask
+	}
+}
+
+func (b *Backend) StencilOpSeparate(face uint32, sfail uint32, dpfail uint32, dppass uint32) {
+	for _, sf := range b.stencilFaces(face) {
+		sf.Fail, sf.DepthFail, sf.Pass = sfail, dpfail, dppass
+	}
+}
+
+func (b *Backend) stencilFaces(face uint32) []*StencilFace {
+	switch face {
+	case gl.FRONT:
+		return []*StencilFace{&b.State.StencilFront}
+	case gl.BACK:
+		return []*StencilFace{&b.State.StencilBack}
+	case gl.FRONT_AND_BACK:
+		return []*StencilFace{&b.State.StencilFront, &b.State.StencilBack}
+	}
+	b.setError(gl.INVALID_ENUM, "invalid stencil face 0x%x", face)
+	return nil
+}
+
+func (b *Backend) MemoryBarrier(barriers uint32) {}
+
+func (b *Backend) FenceSync(condition uint32, flags uint32) uintptr {
+	if condition != gl.SYNC_GPU_COMMANDS_COMPLETE {
+		b.setError(gl.INVALID_ENUM, "glFenceSync: invalid condition 0x%x", condition)
+		return 0
+	}
+	b.nextFence++
+	b.fences[b.nextFence] = true
+	return b.nextFence
+}
+
+// ClientWaitSync returns gl.ALREADY_SIGNALED for all fences since
+// the fake executes commands immediately.
+func (b *Backend) ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32 {
+	if !b.fences[sync] {
+		b.setError(gl.INVALID_VALUE, "glClientWaitSync: %d is not a sync object", sync)
+		return gl.WAIT_FAILED
+	}
+	return gl.ALREADY_SIGNALED
+}
+
+func (b *Backend) DeleteSync(sync uintptr) {
+	if sync == 0 {
+		return
+	}
+	if !b.fences[sync] {
+		b.setError(gl.INVALID_VALUE, "glDeleteSync: %d is not a sync object", sync)
+		return
+	}
+	delete(b.fences, sync)
+}
+
+func (b *Backend) DebugMessageCallback(callback backend.DebugProc, userParam unsafe.Pointer) {
+	b.debugCallback = callback
+}
+
+func (b *Backend) DebugMessageControl(source uint32, xtype uint32, severity uint32, count int32, ids *uint32, enabled bool) {
+	if count < 0 {
+		b.setError(gl.INVALID_VALUE, "glDebugMessageControl: negative count")
+	}
+}
+
+func (b *Backend) PushDebugGroup(source uint32, id uint32, length int32, message *uint8) {
+	b.debugGroups = append(b.debugGroups, string(unsafe.Slice(message, length)))
+}
+
+func (b *Backend) PopDebugGroup() {
+	if len(b.debugGroups) == 0 {
+		b.setError(gl.STACK_UNDERFLOW, "glPopDebugGroup: no debug group pushed")
+		return
+	}
+	b.debugGroups = b.debugGroups[:len(b.debugGroups)-1]
+}
+
+func (b *Backend) ObjectLabel(identifier uint32, name uint32, length int32, label *uint8) {
+	s := string(unsafe.Slice(label, length))
+	var dst *string
+	switch identifier {
+	case gl.BUFFER:
+		if obj := b.buffers[name]; obj != nil {
+			dst = &obj.Label
+		}
+	case gl.VERTEX_ARRAY:
+		if obj := b.vertexArrays[name]; obj != nil && name != 0 {
+			dst = &obj.Label
+		}
+	case gl.PROGRAM:
+		if obj := b.programs[name]; obj != nil {
+			dst = &obj.Label
+		}
+	case gl.SHADER:
+		if obj := b.shaders[name]; obj != nil {
+			dst = &obj.Label
+		}
+	case gl.TEXTURE:
+		if obj := b.textures[name]; obj != nil {
+			dst = &obj.Label
+		}
+	case gl.FRAMEBUFFER:
+		if obj := b.framebuffers[name]; obj != nil && name != 0 {
+			dst = &obj.Label
+		}
+	case gl.RENDERBUFFER:
+		if obj := b.renderbuffers[name]; obj != nil {
+			dst = &obj.Label
+		}
+	default:
+		b.setError(gl.INVALID_ENUM, "glObjectLabel: invalid identifier 0x%x", identifier)
+		return
+	}
+	if dst == nil {
+		b.setError(gl.INVALID_VALUE, "glObjectLabel: %d is not the name of an existing object", name)
+		return
+	}
+	*dst = s
+}
+
+// nativeEndian is the byte order OpenGL uses for client data.
+var nativeEndian binary.ByteOrder = binary.LittleEndian
+
+func init() {
+	v := uint16(1)
+	if *(*byte)(unsafe.Pointer(&v)) == 0 {
+		nativeEndian = binary.BigEndian
+	}
+}
@@ -0,0 +1,507 @@
+package fake
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// Shader is a shader object. Compilation succeeds if the source declares
+// a main function and contains no #error directive.
+type Shader struct {
+	// Type is the shader stage, i.e: gl.VERTEX_SHADER.
+	Type     uint32
+	Source   string
+	Compiled bool
+	InfoLog  string
+	Label    string
+	// deleted is set when the shader is deleted while attached to a program.
+	deleted bool
+}
+
+// Program is a program object. On link the attribute and uniform
+// locations are assigned from the declarations in the shader sources.
+// Unlike a real driver, unused declarations are not removed.
+type Program struct {
+	// Shaders attached to the program.
+	Shaders []uint32
+	Linked  bool
+	// Compute is true if the program was linked from a compute shader.
+	Compute   bool
+	Validated bool
+	InfoLog   string
+	// Attribs maps vertex shader inputs to their location.
+	Attribs map[string]int32
+	// Uniforms maps uniform names to their location.
+	Uniforms map[string]int32
+	// Values holds the values last set for each uniform location.
+	Values map[int32][]float32
+	// FragData holds the locations set with glBindFragDataLocation.
+	FragData map[string]uint32
+	Label    string
+}
+
+// Uniform returns the value last set to the uniform name or nil
+// if the uniform does not exist or was never set.
+func (p *Program) Uniform(name string) []float32 {
+	loc, ok := p.Uniforms[name]
+	if !ok {
+		return nil
+	}
+	return p.Values[loc]
+}
+
+// Shader returns the shader with the given name or nil if there is no such shader.
+func (b *Backend) Shader(name uint32) *Shader { return b.shaders[name] }
+
+// Program returns the program with the given name or nil if there is no such program.
+func (b *Backend) Program(name uint32) *Program { return b.programs[name] }
+
+func (b *Backend) CreateShader(xtype uint32) uint32 {
+	switch xtype {
+	case gl.VERTEX_SHADER, gl.FRAGMENT_SHADER, gl.GEOMETRY_SHADER, gl.COMPUTE_SHADER,
+		gl.TESS_CONTROL_SHADER, gl.TESS_EVALUATION_SHADER:
+	default:
+		b.setError(gl.INVALID_ENUM, "glCreateShader: invalid shader type 0x%x", xtype)
+		return 0
+	}
+	b.nextName[nsShaderProgram]++
+	name := b.nextName[nsShaderProgram]
+	b.shaders[name] = &Shader{Type: xtype}
+	return name
+}
+
+// shader returns the shader named or sets an error if there is no such shader.
+func (b *Backend) shader(fn string, name uint32) *Shader {
+	sh := b.shaders[name]
+	if sh == nil {
+		if b.programs[name] != nil {
+			b.setError(gl.INVALID_OPERATION, "%s: %d is a program, not a shader", fn, name)
+		} else {
+			b.setError(gl.INVALID_VALUE, "%s: %d is not a shader", fn, name)
+		}
+	}
+	return sh
+}
+
+// program returns the program named or sets an error if there is no such program.
+func (b *Backend) program(fn string, name uint32) *Program {
+	p := b.programs[name]
+	if p == nil {
+		if b.shaders[name] != nil {
+			b.setError(gl.INVALID_OPERATION, "%s: %d is a shader, not a program", fn, name)
+		} else {
+			b.setError(gl.INVALID_VALUE, "%s: %d is not a program", fn, name)
+		}
+	}
+	return p
+}
+
+func (b *Backend) DeleteShader(shader uint32) {
+	if shader == 0 {
+		return
+	}
+	sh := b.shader("glDeleteShader", shader)
+	if sh == nil {
+		return
+	}
+	for _, p := range b.programs {
+		for _, attached := range p.Shaders {
+			if attached == shader {
+				// Flagged for deletion, freed once detached.
+				sh.deleted = true
+				return
+			}
+		}
+	}
+	delete(b.shaders, shader)
+}
+
+func (b *Backend) ShaderSource(shader uint32, source string) {
+	if sh := b.shader("glShaderSource", shader); sh != nil {
+		sh.Source = strings.TrimSuffix(source, "\x00")
+	}
+}
+
+func (b *Backend) CompileShader(shader uint32) {
+	sh := b.shader("glCompileShader", shader)
+	if sh == nil {
+		return
+	}
+	src := stripComments(sh.Source)
+	switch {
+	case strings.Contains(src, "#error"):
+		sh.Compiled, sh.InfoLog = false, "0:1(1): error: #error directive"
+	case !mainFunc.MatchString(src):
+		sh.Compiled, sh.InfoLog = false, "0:1(1): error: no main function defined"
+	default:
+		sh.Compiled, sh.InfoLog = true, ""
+	}
+}
+
+func (b *Backend) GetShaderiv(shader uint32, pname uint32, params *int32) {
+	sh := b.shader("glGetShaderiv", shader)
+	if sh == nil {
+		return
+	}
+	switch pname {
+	case gl.SHADER_TYPE:
+		*params = int32(sh.Type)
+	case gl.COMPILE_STATUS:
+		*params = glBool(sh.Compiled)
+	case gl.DELETE_STATUS:
+		*params = glBool(sh.deleted)
+	case gl.INFO_LOG_LENGTH:
+		*params = logLength(sh.InfoLog)
+	case gl.SHADER_SOURCE_LENGTH:
+		*params = logLength(sh.Source)
+	default:
+		b.setError(gl.INVALID_ENUM, "glGetShaderiv: invalid parameter 0x%x", pname)
+	}
+}
+
+func (b *Backend) GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	if sh := b.shader("glGetShaderInfoLog", shader); sh != nil {
+		copyLog(sh.InfoLog, bufSize, length, infoLog)
+	}
+}
+
+func (b *Backend) CreateProgram() uint32 {
+	b.nextName[nsShaderProgram]++
+	name := b.nextName[nsShaderProgram]
+	b.programs[name] = &Program{}
+	return name
+}
+
+func (b *Backend) DeleteProgram(program uint32) {
+	if program == 0 {
+		return
+	}
+	p := b.program("glDeleteProgram", program)
+	if p == nil {
+		return
+	}
+	for _, shader := range p.Shaders {
+		b.detach(p, shader)
+	}
+	delete(b.programs, program)
+	if b.programInUse == program {
+		b.programInUse = 0
+	}
+}
+
+func (b *Backend) AttachShader(program uint32, shader uint32) {
+	const fn = "glAttachShader"
+	p := b.program(fn, program)
+	if p == nil || b.shader(fn, shader) == nil {
+		return
+	}
+	for _, attached := range p.Shaders {
+		if attached == shader {
+			b.setError(gl.INVALID_OPERATION, "%s: shader %d already attached", fn, shader)
+			return
+		}
+	}
+	p.Shaders = append(p.Shaders, shader)
+}
+
+func (b *Backend) DetachShader(program uint32, shader uint32) {
+	const fn = "glDetachShader"
+	p := b.program(fn, program)
+	if p == nil || b.shader(fn, shader) == nil {
+		return
+	}
+	if !b.detach(p, shader) {
+		b.setError(gl.INVALID_OPERATION, "%s: shader %d not attached to program %d", fn, shader, program)
+	}
+}
+
+// detach removes shader from the program and frees it if flagged for deletion.
+func (b *Backend) detach(p *Program, shader uint32) bool {
+	for i, attached := range p.Shaders {
+		if attached != shader {
+			continue
+		}
+		p.Shaders = append(p.Shaders[:i:i], p.Shaders[i+1:]...)
+		if sh := b.shaders[shader]; sh.deleted && !b.isAttached(shader) {
+			delete(b.shaders, shader)
+		}
+		return true
+	}
+	return false
+}
+
+func (b *Backend) isAttached(shader uint32) bool {
+	for _, p := range b.programs {
+		for _, attached := range p.Shaders {
+			if attached == shader {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (b *Backend) LinkProgram(program uint32) {
+	p := b.program("glLinkProgram", program)
+	if p == nil {
+		return
+	}
+	p.Linked, p.Compute, p.Validated, p.InfoLog = false, false, false, ""
+	stages := make(map[uint32]*Shader)
+	for _, name := range p.Shaders {
+		sh := b.shaders[name]
+		if !sh.Compiled {
+			p.InfoLog = "error: linking with uncompiled shader"
+			return
+		}
+		stages[sh.Type] = sh
+	}
+	switch {
+	case stages[gl.COMPUTE_SHADER] != nil:
+		if len(stages) != 1 {
+			p.InfoLog = "error: compute shader linked with other stages"
+			return
+		}
+		p.Compute = true
+	case stages[gl.VERTEX_SHADER] == nil:
+		p.InfoLog = "error: program lacks a vertex shader"
+		return
+	case stages[gl.FRAGMENT_SHADER] == nil:
+		p.InfoLog = "error: program lacks a fragment shader"
+		return
+	}
+	p.Attribs = make(map[string]int32)
+	if vs := stages[gl.VERTEX_SHADER]; vs != nil {
+		assignAttribs(p.Attribs, stripComments(vs.Source))
+	}
+	p.Uniforms = make(map[string]int32)
+	p.Values = make(map[int32][]float32)
+	for _, name := range p.Shaders {
+		assignUniforms(p.Uniforms, stripComments(b.shaders[name].Source))
+	}
+	p.Linked = true
+}
+
+func (b *Backend) ValidateProgram(program uint32) {
+	if p := b.program("glValidateProgram", program); p != nil {
+		p.Validated = p.Linked
+	}
+}
+
+func (b *Backend) GetProgramiv(program uint32, pname uint32, params *int32) {
+	p := b.program("glGetProgramiv", program)
+	if p == nil {
+		return
+	}
+	switch pname {
+	case gl.LINK_STATUS:
+		*params = glBool(p.Linked)
+	case gl.VALIDATE_STATUS:
+		*params = glBool(p.Validated)
+	case gl.INFO_LOG_LENGTH:
+		*params = logLength(p.InfoLog)
+	case gl.ATTACHED_SHADERS:
+		*params = int32(len(p.Shaders))
+	case gl.ACTIVE_ATTRIBUTES:
+		*params = int32(len(p.Attribs))
+	case gl.ACTIVE_UNIFORMS:
+		*params = int32(len(p.Uniforms))
+	default:
+		b.setError(gl.INVALID_ENUM, "glGetProgramiv: invalid parameter 0x%x", pname)
+	}
+}
+
+func (b *Backend) GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	if p := b.program("glGetProgramInfoLog", program); p != nil {
+		copyLog(p.InfoLog, bufSize, length, infoLog)
+	}
+}
+
+func (b *Backend) UseProgram(program uint32) {
+	if program != 0 {
+		p := b.program("glUseProgram", program)
+		if p == nil {
+			return
+		}
+		if !p.Linked {
+			b.setError(gl.INVALID_OPERATION, "glUseProgram: program %d is not linked", program)
+			return
+		}
+	}
+	b.programInUse = program
+}
+
+func (b *Backend) BindFragDataLocation(program uint32, color uint32, name *uint8) {
+	const fn = "glBindFragDataLocation"
+	p := b.program(fn, program)
+	if p == nil {
+		return
+	}
+	if color >= MaxDrawBuffers {
+		b.setError(gl.INVALID_VALUE, "%s: color number %d exceeds GL_MAX_DRAW_BUFFERS", fn, color)
+		return
+	}
+	if p.FragData == nil {
+		p.FragData = make(map[string]uint32)
+	}
+	p.FragData[gl.GoStr(name)] = color
+}
+
+func (b *Backend) GetAttribLocation(program uint32, name *uint8) int32 {
+	return b.location("glGetAttribLocation", program, name, func(p *Program) map[string]int32 { return p.Attribs })
+}
+
+func (b *Backend) GetUniformLocation(program uint32, name *uint8) int32 {
+	return b.location("glGetUniformLocation", program, name, func(p *Program) map[string]int32 { return p.Uniforms })
+}
+
+func (b *Backend) location(fn string, program uint32, name *uint8, locations func(*Program) map[string]int32) int32 {
+	p := b.program(fn, program)
+	if p == nil {
+		return -1
+	}
+	if !p.Linked {
+		b.setError(gl.INVALID_OPERATION, "%s: program %d is not linked", fn, program)
+		return -1
+	}
+	loc, ok := locations(p)[gl.GoStr(name)]
+	if !ok {
+		return -1
+	}
+	return loc
+}
+
+func (b *Backend) Uniform1f(location int32, v0 float32) {
+	b.uniform("glUniform1f", location, v0)
+}
+
+// Uniform1i values are stored as float32 in Program.Values.
+func (b *Backend) Uniform1i(location int32, v0 int32) {
+	b.uniform("glUniform1i", location, float32(v0))
+}
+
+func (b *Backend) Uniform2f(location int32, v0 float32, v1 float32) {
+	b.uniform("glUniform2f", location, v0, v1)
+}
+
+func (b *Backend) Uniform3f(location int32, v0 float32, v1 float32, v2 float32) {
+	b.uniform("glUniform3f", location, v0, v1, v2)
+}
+
+func (b *Backend) Uniform4f(location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	b.uniform("glUniform4f", location, v0, v1, v2, v3)
+}
+
+// uniform sets the value of a uniform of the program in use.
+func (b *Backend) uniform(fn string, location int32, v ...float32) {
+	p := b.programs[b.programInUse]
+	if p == nil {
+		b.setError(gl.INVALID_OPERATION, "%s: no program in use", fn)
+		return
+	}
+	if location == -1 {
+		return // Silently ignored as per the specification.
+	}
+	for _, loc := range p.Uniforms {
+		if loc == location {
+			p.Values[location] = v
+			return
+		}
+	}
+	b.setError(gl.INVALID_OPERATION, "%s: invalid location %d for program %d", fn, location, b.programInUse)
+}
+
+var (
+	comments = regexp.MustCompile(`(?s)//[^\n]*|/\*.*?\*/`)
+	mainFunc = regexp.MustCompile(`\bvoid\s+main\s*\(`)
+	// Matches vertex shader inputs, i.e: "layout(location = 1) in vec3 pos;".
+	attribDecl = regexp.MustCompile(`(?m)^\s*(?:layout\s*\(([^)]*)\)\s*)?(?:(?:highp|mediump|lowp|flat|smooth|noperspective)\s+)*(?:in|attribute)\s+\w+\s+(\w+)\s*;`)
+	// Matches uniforms outside of blocks, i.e: "uniform vec4 color;" or "uniform float weights[5];".
+	uniformDecl       = regexp.MustCompile(`(?m)^\s*(?:layout\s*\([^)]*\)\s*)?uniform\s+(?:(?:highp|mediump|lowp)\s+)?\w+\s+(\w+)\s*(?:\[\s*(\d+)\s*\])?\s*;`)
+	locationQualifier = regexp.MustCompile(`location\s*=\s*(\d+)`)
+)
+
+func stripComments(src string) string {
+	return comments.ReplaceAllString(src, "")
+}
+
+// assignAttribs assigns the locations of the vertex shader inputs in src. Inputs
+// with an explicit location keep it and the rest take the lowest free locations.
+func assignAttribs(attribs map[string]int32, src string) {
+	used := make(map[int32]bool)
+	var implicit []string
+	for _, m := range attribDecl.FindAllStringSubmatch(src, -1) {
+		if loc := locationQualifier.FindStringSubmatch(m[1]); loc != nil {
+			n, _ := strconv.Atoi(loc[1])
+			attribs[m[2]] = int32(n)
+			used[int32(n)] = true
+			continue
+		}
+		implicit = append(implicit, m[2])
+	}
+	next := int32(0)
+	for _, name := range implicit {
+		for used[next] {
+			next++
+		}
+		attribs[name] = next
+		used[next] = true
+	}
+}
+
+// assignUniforms assigns consecutive locations to the uniforms declared in src.
+// Array elements are given consecutive locations and the array name refers to its first element.
+func assignUniforms(uniforms map[string]int32, src string) {
+	for _, m := range uniformDecl.FindAllStringSubmatch(src, -1) {
+		name := m[1]
+		if _, ok := uniforms[name]; ok {
+			continue // Declared in multiple stages.
+		}
+		loc := int32(len(uniforms))
+		for _, l := range uniforms {
+			if l >= loc {
+				loc = l + 1
+			}
+		}
+		uniforms[name] = loc
+		if m[2] == "" {
+			continue
+		}
+		n, _ := strconv.Atoi(m[2])
+		for i := 0; i < n; i++ {
+			uniforms[name+"["+strconv.Itoa(i)+"]"] = loc + int32(i)
+		}
+	}
+}
+
+func glBool(b bool) int32 {
+	if b {
+		return gl.TRUE
+	}
+	return gl.FALSE
+}
+
+// logLength returns the length of log including the null terminator, or zero if empty.
+func logLength(log string) int32 {
+	if log == "" {
+		return 0
+	}
+	return int32(len(log)) + 1
+}
+
+// copyLog implements glGet*InfoLog.
+func copyLog(log string, bufSize int32, length *int32, infoLog *uint8) {
+	if bufSize <= 0 {
+		return
+	}
+	dst := unsafe.Slice(infoLog, bufSize)
+	n := copy(dst[:bufSize-1], log)
+	dst[n] = 0
+	if length != nil {
+		*length = int32(n)
+	}
+}
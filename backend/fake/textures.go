@@ -0,0 +1,743 @@
+package fake
+
+import (
+	"math"
+	"unsafe"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// Texture is a two dimensional texture object. Only the base level is stored.
+type Texture struct {
+	Width, Height int32
+	// InternalFormat is the internal format of the storage, i.e: gl.RGBA8.
+	InternalFormat uint32
+	// Texels holds the RGBA value of every texel with rows ordered bottom to top as in
+	// OpenGL. Values are quantized to the precision of the internal format and depth
+	// formats store depth in the red component. Components the format lacks read as 0 except alpha which reads as 1.
+	Texels []float32
+	// Params holds the parameters set with glTexParameteri.
+	Params map[uint32]int32
+	Label  string
+}
+
+// Framebuffer is a framebuffer object. Framebuffer 0 is the default framebuffer.
+type Framebuffer struct {
+	// Attachments maps attachment points, i.e: gl.COLOR_ATTACHMENT0, to the attached object.
+	Attachments map[uint32]Attachment
+	DrawBuffers []uint32
+	ReadBuffer  uint32
+	Label       string
+	// backbuffer is the color storage of the default framebuffer.
+	backbuffer *Texture
+}
+
+// Attachment is the texture or renderbuffer attached to a framebuffer attachment point.
+type Attachment struct {
+	Texture      uint32
+	Renderbuffer uint32
+}
+
+// Renderbuffer is a renderbuffer object.
+type Renderbuffer struct {
+	Width, Height  int32
+	InternalFormat uint32
+	Label          string
+	storage        *Texture
+}
+
+// Texture returns the texture with the given name or nil if there is no such texture.
+func (b *Backend) Texture(name uint32) *Texture { return b.textures[name] }
+
+// Framebuffer returns the framebuffer with the given name or nil if there is no such
+// framebuffer. Name 0 returns the default framebuffer.
+func (b *Backend) Framebuffer(name uint32) *Framebuffer { return b.framebuffers[name] }
+
+// Renderbuffer returns the renderbuffer with the given name or nil if there is no such renderbuffer.
+func (b *Backend) Renderbuffer(name uint32) *Renderbuffer { return b.renderbuffers[name] }
+
+// Backbuffer returns the color buffer of the default framebuffer.
+func (b *Backend) Backbuffer() *Texture { return b.framebuffers[0].backbuffer }
+
+// formatInfo describes an internal format.
+type formatInfo struct {
+	components int
+	// bits of normalized fixed point components. Zero for floating point.
+	bits  int
+	depth bool
+}
+
+func internalFormatInfo(internal uint32) (formatInfo, bool) {
+	switch internal {
+	case gl.RGBA8, gl.SRGB8_ALPHA8, gl.RGBA:
+		return formatInfo{components: 4, bits: 8}, true
+	case gl.RGBA16:
+		return formatInfo{components: 4, bits: 16}, true
+	case gl.RGBA16F, gl.RGBA32F:
+		return formatInfo{components: 4}, true
+	case gl.RGB8, gl.SRGB8, gl.RGB:
+		return formatInfo{components: 3, bits: 8}, true
+	case gl.RGB16F, gl.RGB32F:
+		return formatInfo{components: 3}, true
+	case gl.RG8, gl.RG:
+		return formatInfo{components: 2, bits: 8}, true
+	case gl.RG16F, gl.RG32F:
+		return formatInfo{components: 2}, true
+	case gl.R8, gl.RED:
+		return formatInfo{components: 1, bits: 8}, true
+	case gl.R16F, gl.R32F:
+		return formatInfo{components: 1}, true
+	case gl.DEPTH_COMPONENT16:
+		return formatInfo{components: 1, bits: 16, depth: true}, true
+	case gl.DEPTH_COMPONENT24, gl.DEPTH_COMPONENT, gl.DEPTH24_STENCIL8, gl.DEPTH_STENCIL:
+		return formatInfo{components: 1, bits: 24, depth: true}, true
+	case gl.DEPTH_COMPONENT32F, gl.DEPTH32F_STENCIL8:
+		return formatInfo{components: 1, depth: true}, true
+	}
+	return formatInfo{}, false
+}
+
+func newTexture(width, height int32, internal uint32) *Texture {
+	t := &Texture{Params: make(map[uint32]int32)}
+	t.setStorage(width, height, internal)
+	return t
+}
+
+func (t *Texture) setStorage(width, height int32, internal uint32) {
+	t.Width, t.Height, t.InternalFormat = width, height, internal
+	t.Texels = make([]float32, 4*width*height)
+	// Formats without alpha read as opaque.
+	if info, _ := internalFormatInfo(internal); info.components < 4 {
+		for i := 3; i < len(t.Texels); i += 4 {
+			t.Texels[i] = 1
+		}
+	}
+}
+
+// store sets the texel at index i to v quantized to the internal format.
+func (t *Texture) store(i int, v [4]float32, mask [4]bool) {
+	info, _ := internalFormatInfo(t.InternalFormat)
+	texel := t.Texels[4*i : 4*i+4]
+	for c := range v {
+		if !mask[c] || c >= info.components {
+			continue
+		}
+		x := v[c]
+		if info.bits > 0 {
+			max := float32(uint32(1)<<info.bits - 1)
+			x = float32(math.Round(float64(clamp01(x)*max))) / max
+		} else if info.depth {
+			x = clamp01(x)
+		}
+		texel[c] = x
+	}
+}
+
+func clamp01(x float32) float32 {
+	if x < 0 {
+		return 0
+	} else if x > 1 {
+		return 1
+	}
+	return x
+}
+
+func (b *Backend) GenTextures(n int32, textures *uint32) {
+	b.genNames(nsTexture, n, textures, func(name uint32) {
+		b.textures[name] = &Texture{Params: make(map[uint32]int32)}
+	})
+}
+
+func (b *Backend) DeleteTextures(n int32, textures *uint32) {
+	for _, name := range unsafe.Slice(textures, n) {
+		if name == 0 || b.textures[name] == nil {
+			continue
+		}
+		delete(b.textures, name)
+		for i, bound := range b.textureUnits {
+			if bound == name {
+				b.textureUnits[i] = 0
+			}
+		}
+		b.detachFromBound(Attachment{Texture: name})
+	}
+}
+
+// detachFromBound detaches the object from the bound framebuffers as
+// OpenGL does when deleting attached textures and renderbuffers.
+func (b *Backend) detachFromBound(obj Attachment) {
+	for _, fbName := range []uint32{b.drawFramebuffer, b.readFramebuffer} {
+		fb := b.framebuffers[fbName]
+		for point, att := range fb.Attachments {
+			if att == obj {
+				delete(fb.Attachments, point)
+			}
+		}
+	}
+}
+
+func (b *Backend) ActiveTexture(texture uint32) {
+	unit := texture - gl.TEXTURE0
+	if unit >= MaxTextureUnits {
+		b.setError(gl.INVALID_ENUM, "glActiveTexture: invalid texture unit 0x%x", texture)
+		return
+	}
+	b.activeUnit = unit
+}
+
+func (b *Backend) BindTexture(target uint32, texture uint32) {
+	if target != gl.TEXTURE_2D {
+		b.setError(gl.INVALID_ENUM, "glBindTexture: target 0x%x not supported by fake", target)
+		return
+	}
+	if texture != 0 && b.textures[texture] == nil {
+		b.setError(gl.INVALID_VALUE, "glBindTexture: %d is not a name returned by glGenTextures", texture)
+		return
+	}
+	b.textureUnits[b.activeUnit] = texture
+}
+
+// boundTexture returns the texture bound to the active unit or sets an error if there is none.
+func (b *Backend) boundTexture(fn string, target uint32) *Texture {
+	if target != gl.TEXTURE_2D {
+		b.setError(gl.INVALID_ENUM, "%s: target 0x%x not supported by fake", fn, target)
+		return nil
+	}
+	t := b.textures[b.textureUnits[b.activeUnit]]
+	if t == nil {
+		b.setError(gl.INVALID_OPERATION, "%s: no texture bound", fn)
+	}
+	return t
+}
+
+func (b *Backend) TexImage2D(target uint32, level int32, internalformat int32, width int32, height int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	const fn = "glTexImage2D"
+	t := b.boundTexture(fn, target)
+	if t == nil {
+		return
+	}
+	info, ok := internalFormatInfo(uint32(internalformat))
+	if !ok {
+		b.setError(gl.INVALID_VALUE, "%s: unsupported internal format 0x%x", fn, internalformat)
+		return
+	}
+	if width < 0 || height < 0 || border != 0 || level < 0 {
+		b.setError(gl.INVALID_VALUE, "%s: invalid dimensions, level or border", fn)
+		return
+	}
+	pixelBytes, ok := pixelSize(format, xtype)
+	if !ok {
+		b.setError(gl.INVALID_ENUM, "%s: invalid format 0x%x or type 0x%x", fn, format, xtype)
+		return
+	}
+	if info.depth != (format == gl.DEPTH_COMPONENT || format == gl.DEPTH_STENCIL) {
+		b.setError(gl.INVALID_OPERATION, "%s: format 0x%x incompatible with internal format 0x%x", fn, format, internalformat)
+		return
+	}
+	if level > 0 {
+		return // Mipmaps are not stored.
+	}
+	t.setStorage(width, height, uint32(internalformat))
+	src := b.clientPixels(fn, gl.PIXEL_UNPACK_BUFFER, pixels, width, height, pixelBytes)
+	if src == nil {
+		return
+	}
+	stride := rowStride(int(width)*pixelBytes, b.pixelStore[gl.UNPACK_ALIGNMENT])
+	all := [4]bool{true, true, true, true}
+	for y := 0; y < int(height); y++ {
+		for x := 0; x < int(width); x++ {
+			off := y*stride + x*pixelBytes
+			t.store(y*int(width)+x, decodePixel(src[off:off+pixelBytes], format, xtype), all)
+		}
+	}
+}
+
+func (b *Backend) TexParameteri(target uint32, pname uint32, param int32) {
+	if t := b.boundTexture("glTexParameteri", target); t != nil {
+		t.Params[pname] = param
+	}
+}
+
+// GenerateMipmap only validates the bound texture since the fake stores the base level alone.
+func (b *Backend) GenerateMipmap(target uint32) {
+	b.boundTexture("glGenerateMipmap", target)
+}
+
+func (b *Backend) GetTexImage(target uint32, level int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	const fn = "glGetTexImage"
+	t := b.boundTexture(fn, target)
+	if t == nil {
+		return
+	}
+	if level != 0 {
+		b.setError(gl.INVALID_VALUE, "%s: level %d not stored by fake", fn, level)
+		return
+	}
+	b.readTexels(fn, t, 0, 0, t.Width, t.Height, format, xtype, pixels)
+}
+
+// readTexels encodes a rectangle of t into client memory or the pixel pack buffer.
+func (b *Backend) readTexels(fn string, t *Texture, x, y, width, height int32, format, xtype uint32, pixels unsafe.Pointer) {
+	pixelBytes, ok := pixelSize(format, xtype)
+	if !ok {
+		b.setError(gl.INVALID_ENUM, "%s: invalid format 0x%x or type 0x%x", fn, format, xtype)
+		return
+	}
+	dst := b.clientPixels(fn, gl.PIXEL_PACK_BUFFER, pixels, width, height, pixelBytes)
+	if dst == nil {
+		return
+	}
+	stride := rowStride(int(width)*pixelBytes, b.pixelStore[gl.PACK_ALIGNMENT])
+	for row := int32(0); row < height; row++ {
+		for col := int32(0); col < width; col++ {
+			sx, sy := x+col, y+row
+			if sx < 0 || sy < 0 || sx >= t.Width || sy >= t.Height {
+				continue // Pixels outside the source are undefined and left untouched.
+			}
+			var v [4]float32
+			copy(v[:], t.Texels[4*(sy*t.Width+sx):])
+			off := int(row)*stride + int(col)*pixelBytes
+			encodePixel(dst[off:off+pixelBytes], format, xtype, v)
+		}
+	}
+}
+
+// clientPixels returns the client memory of a pixel transfer. If a buffer is
+// bound to the pixel buffer target pixels is an offset into the buffer.
+// It returns nil if pixels is nil or the transfer is out of range.
+func (b *Backend) clientPixels(fn string, target uint32, pixels unsafe.Pointer, width, height int32, pixelBytes int) []byte {
+	alignment := b.pixelStore[gl.UNPACK_ALIGNMENT]
+	if target == gl.PIXEL_PACK_BUFFER {
+		alignment = b.pixelStore[gl.PACK_ALIGNMENT]
+	}
+	size := 0
+	if width > 0 && height > 0 {
+		size = int(height-1)*rowStride(int(width)*pixelBytes, alignment) + int(width)*pixelBytes
+	}
+	if buf := b.buffers[b.bufferBindings[target]]; buf != nil {
+		offset := int(uintptr(pixels))
+		if offset+size > len(buf.Data) {
+			b.setError(gl.INVALID_OPERATION, "%s: transfer of %d bytes at offset %d exceeds pixel buffer size %d", fn, size, offset, len(buf.Data))
+			return nil
+		}
+		return buf.Data[offset : offset+size]
+	}
+	if pixels == nil || size == 0 {
+		return nil
+	}
+	return unsafe.Slice((*byte)(pixels), size)
+}
+
+func rowStride(rowBytes int, alignment int32) int {
+	a := int(alignment)
+	return (rowBytes + a - 1) / a * a
+}
+
+// pixelSize returns the size in bytes of a pixel in client memory.
+func pixelSize(format, xtype uint32) (int, bool) {
+	if format == gl.DEPTH_STENCIL {
+		return 4, xtype == gl.UNSIGNED_INT_24_8
+	}
+	components := 0
+	switch format {
+	case gl.RGBA:
+		components = 4
+	case gl.RGB:
+		components = 3
+	case gl.RG:
+		components = 2
+	case gl.RED, gl.DEPTH_COMPONENT:
+		components = 1
+	default:
+		return 0, false
+	}
+	switch xtype {
+	case gl.UNSIGNED_BYTE:
+		return components, true
+	case gl.UNSIGNED_SHORT:
+		return 2 * components, true
+	case gl.UNSIGNED_INT, gl.FLOAT:
+		return 4 * components, true
+	}
+	return 0, false
+}
+
+func decodePixel(src []byte, format, xtype uint32) [4]float32 {
+	v := [4]float32{0, 0, 0, 1}
+	if format == gl.DEPTH_STENCIL {
+		v[0] = float32(nativeEndian.Uint32(src)>>8) / 0xffffff
+		return v
+	}
+	size := len(src) / componentCount(format)
+	for c := range v[:componentCount(format)] {
+		s := src[c*size:]
+		switch xtype {
+		case gl.UNSIGNED_BYTE:
+			v[c] = float32(s[0]) / 0xff
+		case gl.UNSIGNED_SHORT:
+			v[c] = float32(nativeEndian.Uint16(s)) / 0xffff
+		case gl.UNSIGNED_INT:
+			v[c] = float32(float64(nativeEndian.Uint32(s)) / 0xffffffff)
+		case gl.FLOAT:
+			v[c] = math.Float32frombits(nativeEndian.Uint32(s))
+		}
+	}
+	return v
+}
+
+func encodePixel(dst []byte, format, xtype uint32, v [4]float32) {
+	if format == gl.DEPTH_STENCIL {
+		nativeEndian.PutUint32(dst, uint32(math.Round(float64(clamp01(v[0])*0xffffff)))<<8)
+		return
+	}
+	size := len(dst) / componentCount(format)
+	for c := range v[:componentCount(format)] {
+		d := dst[c*size:]
+		switch xtype {
+		case gl.UNSIGNED_BYTE:
+			d[0] = uint8(math.Round(float64(clamp01(v[c]) * 0xff)))
+		case gl.UNSIGNED_SHORT:
+			nativeEndian.PutUint16(d, uint16(math.Round(float64(clamp01(v[c])*0xffff))))
+		case gl.UNSIGNED_INT:
+			nativeEndian.PutUint32(d, uint32(math.Round(float64(clamp01(v[c]))*0xffffffff)))
+		case gl.FLOAT:
+			nativeEndian.PutUint32(d, math.Float32bits(v[c]))
+		}
+	}
+}
+
+func componentCount(format uint32) int {
+	switch format {
+	case gl.RGBA:
+		return 4
+	case gl.RGB:
+		return 3
+	case gl.RG:
+		return 2
+	}
+	return 1
+}
+
+func (b *Backend) GenFramebuffers(n int32, framebuffers *uint32) {
+	b.genNames(nsFramebuffer, n, framebuffers, func(name uint32) {
+		b.framebuffers[name] = &Framebuffer{
+			Attachments: make(map[uint32]Attachment),
+			DrawBuffers: []uint32{gl.COLOR_ATTACHMENT0},
+			ReadBuffer:  gl.COLOR_ATTACHMENT0,
+		}
+	})
+}
+
+func (b *Backend) DeleteFramebuffers(n int32, framebuffers *uint32) {
+	for _, name := range unsafe.Slice(framebuffers, n) {
+		if name == 0 || b.framebuffers[name] == nil {
+			continue
+		}
+		delete(b.framebuffers, name)
+		if b.drawFramebuffer == name {
+			b.drawFramebuffer = 0
+		}
+		if b.readFramebuffer == name {
+			b.readFramebuffer = 0
+		}
+	}
+}
+
+func (b *Backend) BindFramebuffer(target uint32, framebuffer uint32) {
+	if b.framebuffers[framebuffer] == nil {
+		b.setError(gl.INVALID_OPERATION, "glBindFramebuffer: %d is not a name returned by glGenFramebuffers", framebuffer)
+		return
+	}
+	switch target {
+	case gl.FRAMEBUFFER:
+		b.drawFramebuffer, b.readFramebuffer = framebuffer, framebuffer
+	case gl.DRAW_FRAMEBUFFER:
+		b.drawFramebuffer = framebuffer
+	case gl.READ_FRAMEBUFFER:
+		b.readFramebuffer = framebuffer
+	default:
+		b.setError(gl.INVALID_ENUM, "glBindFramebuffer: invalid target 0x%x", target)
+	}
+}
+
+// boundFramebuffer returns the name of the framebuffer bound to target.
+func (b *Backend) boundFramebuffer(fn string, target uint32) (uint32, bool) {
+	switch target {
+	case gl.FRAMEBUFFER, gl.DRAW_FRAMEBUFFER:
+		return b.drawFramebuffer, true
+	case gl.READ_FRAMEBUFFER:
+		return b.readFramebuffer, true
+	}
+	b.setError(gl.INVALID_ENUM, "%s: invalid target 0x%x", fn, target)
+	return 0, false
+}
+
+// attach attaches obj to the framebuffer bound to target.
+func (b *Backend) attach(fn string, target, attachment uint32, obj Attachment) {
+	name, ok := b.boundFramebuffer(fn, target)
+	if !ok {
+		return
+	}
+	if name == 0 {
+		b.setError(gl.INVALID_OPERATION, "%s: default framebuffer bound", fn)
+		return
+	}
+	switch {
+	case attachment >= gl.COLOR_ATTACHMENT0 && attachment < gl.COLOR_ATTACHMENT0+MaxDrawBuffers:
+	case attachment == gl.DEPTH_ATTACHMENT, attachment == gl.STENCIL_ATTACHMENT, attachment == gl.DEPTH_STENCIL_ATTACHMENT:
+	default:
+		b.setError(gl.INVALID_ENUM, "%s: invalid attachment 0x%x", fn, attachment)
+		return
+	}
+	fb := b.framebuffers[name]
+	if obj == (Attachment{}) {
+		delete(fb.Attachments, attachment)
+		return
+	}
+	fb.Attachments[attachment] = obj
+}
+
+func (b *Backend) FramebufferTexture2D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	const fn = "glFramebufferTexture2D"
+	if texture != 0 && b.textures[texture] == nil {
+		b.setError(gl.INVALID_OPERATION, "%s: %d is not an existing texture", fn, texture)
+		return
+	}
+	if textarget != gl.TEXTURE_2D {
+		b.setError(gl.INVALID_ENUM, "%s: texture target 0x%x not supported by fake", fn, textarget)
+		return
+	}
+	b.attach(fn, target, attachment, Attachment{Texture: texture})
+}
+
+func (b *Backend) FramebufferRenderbuffer(target uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32) {
+	const fn = "glFramebufferRenderbuffer"
+	if renderbuffer != 0 && b.renderbuffers[renderbuffer] == nil {
+		b.setError(gl.INVALID_OPERATION, "%s: %d is not an existing renderbuffer", fn, renderbuffer)
+		return
+	}
+	if renderbuffertarget != gl.RENDERBUFFER {
+		b.setError(gl.INVALID_ENUM, "%s: invalid renderbuffer target 0x%x", fn, renderbuffertarget)
+		return
+	}
+	b.attach(fn, target, attachment, Attachment{Renderbuffer: renderbuffer})
+}
+
+// validBuffer reports whether buf may be selected as a draw or read buffer of framebuffer name.
+func validBuffer(name, buf uint32) bool {
+	if buf == gl.NONE {
+		return true
+	}
+	if name == 0 {
+		return buf == gl.BACK || buf == gl.FRONT || buf == gl.BACK_LEFT || buf == gl.FRONT_LEFT
+	}
+	return buf >= gl.COLOR_ATTACHMENT0 && buf < gl.COLOR_ATTACHMENT0+MaxDrawBuffers
+}
+
+func (b *Backend) DrawBuffers(n int32, bufs *uint32) {
+	if n < 0 || n > MaxDrawBuffers {
+		b.setError(gl.INVALID_VALUE, "glDrawBuffers: invalid count %d", n)
+		return
+	}
+	buffers := append([]uint32(nil), unsafe.Slice(bufs, n)...)
+	for _, buf := range buffers {
+		if !validBuffer(b.drawFramebuffer, buf) {
+			b.setError(gl.INVALID_OPERATION, "glDrawBuffers: invalid buffer 0x%x for framebuffer %d", buf, b.drawFramebuffer)
+			return
+		}
+	}
+	b.framebuffers[b.drawFramebuffer].DrawBuffers = buffers
+}
+
+func (b *Backend) ReadBuffer(src uint32) {
+	if !validBuffer(b.readFramebuffer, src) {
+		b.setError(gl.INVALID_OPERATION, "glReadBuffer: invalid buffer 0x%x for framebuffer %d", src, b.readFramebuffer)
+		return
+	}
+	b.framebuffers[b.readFramebuffer].ReadBuffer = src
+}
+
+// image returns the storage of the buffer of framebuffer name, i.e:
+// gl.COLOR_ATTACHMENT0 or gl.BACK, or nil if nothing is attached.
+func (b *Backend) image(name, buf uint32) *Texture {
+	fb := b.framebuffers[name]
+	if name == 0 {
+		if buf == gl.NONE {
+			return nil
+		}
+		return fb.backbuffer
+	}
+	att, ok := fb.Attachments[buf]
+	if !ok && buf == gl.DEPTH_ATTACHMENT {
+		att, ok = fb.Attachments[gl.DEPTH_STENCIL_ATTACHMENT]
+	}
+	if !ok {
+		return nil
+	}
+	if att.Texture != 0 {
+		return b.textures[att.Texture]
+	}
+	if rb := b.renderbuffers[att.Renderbuffer]; rb != nil {
+		return rb.storage
+	}
+	return nil
+}
+
+func (b *Backend) CheckFramebufferStatus(target uint32) uint32 {
+	name, ok := b.boundFramebuffer("glCheckFramebufferStatus", target)
+	if !ok {
+		return 0
+	}
+	return b.framebufferStatus(name)
+}
+
+func (b *Backend) framebufferStatus(name uint32) uint32 {
+	if name == 0 {
+		return gl.FRAMEBUFFER_COMPLETE
+	}
+	fb := b.framebuffers[name]
+	if len(fb.Attachments) == 0 {
+		return gl.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT
+	}
+	for point := range fb.Attachments {
+		img := b.image(name, point)
+		if img == nil || img.Width == 0 || img.Height == 0 {
+			return gl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT
+		}
+		info, _ := internalFormatInfo(img.InternalFormat)
+		isColor := point >= gl.COLOR_ATTACHMENT0 && point < gl.COLOR_ATTACHMENT0+MaxDrawBuffers
+		if isColor == info.depth {
+			return gl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT
+		}
+	}
+	return gl.FRAMEBUFFER_COMPLETE
+}
+
+// Clear writes the clear values to the buffers of the draw framebuffer.
+// It honors the scissor test, color mask and depth mask.
+func (b *Backend) Clear(mask uint32) {
+	const fn = "glClear"
+	if mask&^(gl.COLOR_BUFFER_BIT|gl.DEPTH_BUFFER_BIT|gl.STENCIL_BUFFER_BIT) != 0 {
+		b.setError(gl.INVALID_VALUE, "%s: invalid mask 0x%x", fn, mask)
+		return
+	}
+	if b.framebufferStatus(b.drawFramebuffer) != gl.FRAMEBUFFER_COMPLETE {
+		b.setError(gl.INVALID_FRAMEBUFFER_OPERATION, "%s: framebuffer %d incomplete", fn, b.drawFramebuffer)
+		return
+	}
+	fill := func(img *Texture, v [4]float32, colorMask [4]bool) {
+		x0, y0, x1, y1 := int32(0), int32(0), img.Width, img.Height
+		if b.caps[gl.SCISSOR_TEST] {
+			s := b.State.Scissor
+			x0, y0 = max32(x0, s[0]), max32(y0, s[1])
+			x1, y1 = min32(x1, s[0]+s[2]), min32(y1, s[1]+s[3])
+		}
+		for y := y0; y < y1; y++ {
+			for x := x0; x < x1; x++ {
+				img.store(int(y*img.Width+x), v, colorMask)
+			}
+		}
+	}
+	if mask&gl.COLOR_BUFFER_BIT != 0 {
+		for _, buf := range b.framebuffers[b.drawFramebuffer].DrawBuffers {
+			if img := b.image(b.drawFramebuffer, buf); img != nil {
+				fill(img, b.State.ClearColor, b.State.ColorMask)
+			}
+		}
+	}
+	if mask&gl.DEPTH_BUFFER_BIT != 0 && b.State.DepthMask && b.drawFramebuffer != 0 {
+		if img := b.image(b.drawFramebuffer, gl.DEPTH_ATTACHMENT); img != nil {
+			fill(img, [4]float32{float32(b.State.ClearDepth)}, [4]bool{true})
+		}
+	}
+}
+
+func (b *Backend) ReadPixels(x int32, y int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	const fn = "glReadPixels"
+	if width < 0 || height < 0 {
+		b.setError(gl.INVALID_VALUE, "%s: negative dimensions", fn)
+		return
+	}
+	if b.framebufferStatus(b.readFramebuffer) != gl.FRAMEBUFFER_COMPLETE {
+		b.setError(gl.INVALID_FRAMEBUFFER_OPERATION, "%s: framebuffer %d incomplete", fn, b.readFramebuffer)
+		return
+	}
+	buf := b.framebuffers[b.readFramebuffer].ReadBuffer
+	if format == gl.DEPTH_COMPONENT || format == gl.DEPTH_STENCIL {
+		buf = gl.DEPTH_ATTACHMENT
+	}
+	img := b.image(b.readFramebuffer, buf)
+	if img == nil {
+		b.setError(gl.INVALID_OPERATION, "%s: no buffer to read from", fn)
+		return
+	}
+	b.readTexels(fn, img, x, y, width, height, format, xtype, pixels)
+}
+
+func (b *Backend) GenRenderbuffers(n int32, renderbuffers *uint32) {
+	b.genNames(nsRenderbuffer, n, renderbuffers, func(name uint32) {
+		b.renderbuffers[name] = &Renderbuffer{}
+	})
+}
+
+func (b *Backend) DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	for _, name := range unsafe.Slice(renderbuffers, n) {
+		if name == 0 || b.renderbuffers[name] == nil {
+			continue
+		}
+		delete(b.renderbuffers, name)
+		if b.renderbuffer == name {
+			b.renderbuffer = 0
+		}
+		b.detachFromBound(Attachment{Renderbuffer: name})
+	}
+}
+
+func (b *Backend) BindRenderbuffer(target uint32, renderbuffer uint32) {
+	if target != gl.RENDERBUFFER {
+		b.setError(gl.INVALID_ENUM, "glBindRenderbuffer: invalid target 0x%x", target)
+		return
+	}
+	if renderbuffer != 0 && b.renderbuffers[renderbuffer] == nil {
+		b.setError(gl.INVALID_OPERATION, "glBindRenderbuffer: %d is not a name returned by glGenRenderbuffers", renderbuffer)
+		return
+	}
+	b.renderbuffer = renderbuffer
+}
+
+func (b *Backend) RenderbufferStorage(target uint32, internalformat uint32, width int32, height int32) {
+	const fn = "glRenderbufferStorage"
+	if target != gl.RENDERBUFFER {
+		b.setError(gl.INVALID_ENUM, "%s: invalid target 0x%x", fn, target)
+		return
+	}
+	rb := b.renderbuffers[b.renderbuffer]
+	if rb == nil {
+		b.setError(gl.INVALID_OPERATION, "%s: no renderbuffer bound", fn)
+		return
+	}
+	if _, ok := internalFormatInfo(internalformat); !ok {
+		b.setError(gl.INVALID_ENUM, "%s: unsupported internal format 0x%x", fn, internalformat)
+		return
+	}
+	if width < 0 || height < 0 {
+		b.setError(gl.INVALID_VALUE, "%s: negative dimensions", fn)
+		return
+	}
+	rb.Width, rb.Height, rb.InternalFormat = width, height, internalformat
+	rb.storage = newTexture(width, height, internalformat)
+}
+
+func max32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min32(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
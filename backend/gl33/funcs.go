@@ -0,0 +1,417 @@
+// Code generated by genbackend -mode=adapter from github.com/go-gl/gl/v3.3-core/gl; DO NOT EDIT.
+
+package gl33
+
+import (
+	"unsafe"
+
+	"github.com/go-gl/gl/v3.3-core/gl"
+)
+
+func (Backend) ActiveTexture(texture uint32) {
+	gl.ActiveTexture(texture)
+}
+
+func (Backend) AttachShader(program uint32, shader uint32) {
+	gl.AttachShader(program, shader)
+}
+
+func (Backend) BindBuffer(target uint32, buffer uint32) {
+	gl.BindBuffer(target, buffer)
+}
+
+func (Backend) BindBufferBase(target uint32, index uint32, buffer uint32) {
+	gl.BindBufferBase(target, index, buffer)
+}
+
+func (Backend) BindFragDataLocation(program uint32, color uint32, name *uint8) {
+	gl.BindFragDataLocation(program, color, name)
+}
+
+func (Backend) BindFramebuffer(target uint32, framebuffer uint32) {
+	gl.BindFramebuffer(target, framebuffer)
+}
+
+func (Backend) BindRenderbuffer(target uint32, renderbuffer uint32) {
+	gl.BindRenderbuffer(target, renderbuffer)
+}
+
+func (Backend) BindTexture(target uint32, texture uint32) {
+	gl.BindTexture(target, texture)
+}
+
+func (Backend) BindVertexArray(array uint32) {
+	gl.BindVertexArray(array)
+}
+
+func (Backend) BlendEquationSeparate(modeRGB uint32, modeAlpha uint32) {
+	gl.BlendEquationSeparate(modeRGB, modeAlpha)
+}
+
+func (Backend) BlendEquationSeparatei(buf uint32, modeRGB uint32, modeAlpha uint32) {
+	gl.BlendEquationSeparateiARB(buf, modeRGB, modeAlpha)
+}
+
+func (Backend) BlendFuncSeparate(sfactorRGB uint32, dfactorRGB uint32, sfactorAlpha uint32, dfactorAlpha uint32) {
+	gl.BlendFuncSeparate(sfactorRGB, dfactorRGB, sfactorAlpha, dfactorAlpha)
+}
+
+func (Backend) BlendFuncSeparatei(buf uint32, srcRGB uint32, dstRGB uint32, srcAlpha uint32, dstAlpha uint32) {
+	gl.BlendFuncSeparateiARB(buf, srcRGB, dstRGB, srcAlpha, dstAlpha)
+}
+
+func (Backend) BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	gl.BufferData(target, size, data, usage)
+}
+
+func (Backend) BufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	gl.BufferSubData(target, offset, size, data)
+}
+
+func (Backend) CheckFramebufferStatus(target uint32) uint32 {
+	return gl.CheckFramebufferStatus(target)
+}
+
+func (Backend) Clear(mask uint32) {
+	gl.Clear(mask)
+}
+
+func (Backend) ClearColor(red float32, green float32, blue float32, alpha float32) {
+	gl.ClearColor(red, green, blue, alpha)
+}
+
+func (Backend) ClearDepth(depth float64) {
+	gl.ClearDepth(depth)
+}
+
+func (Backend) ClearStencil(s int32) {
+	gl.ClearStencil(s)
+}
+
+func (Backend) ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32 {
+	return gl.ClientWaitSync(sync, flags, timeout)
+}
+
+func (Backend) ColorMask(red bool, green bool, blue bool, alpha bool) {
+	gl.ColorMask(red, green, blue, alpha)
+}
+
+func (Backend) CompileShader(shader uint32) {
+	gl.CompileShader(shader)
+}
+
+func (Backend) CreateProgram() uint32 {
+	return gl.CreateProgram()
+}
+
+func (Backend) CreateShader(xtype uint32) uint32 {
+	return gl.CreateShader(xtype)
+}
+
+func (Backend) CullFace(mode uint32) {
+	gl.CullFace(mode)
+}
+
+func (Backend) DebugMessageControl(source uint32, xtype uint32, severity uint32, count int32, ids *uint32, enabled bool) {
+	gl.DebugMessageControl(source, xtype, severity, count, ids, enabled)
+}
+
+func (Backend) DeleteBuffers(n int32, buffers *uint32) {
+	gl.DeleteBuffers(n, buffers)
+}
+
+func (Backend) DeleteFramebuffers(n int32, framebuffers *uint32) {
+	gl.DeleteFramebuffers(n, framebuffers)
+}
+
+func (Backend) DeleteProgram(program uint32) {
+	gl.DeleteProgram(program)
+}
+
+func (Backend) DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	gl.DeleteRenderbuffers(n, renderbuffers)
+}
+
+func (Backend) DeleteShader(shader uint32) {
+	gl.DeleteShader(shader)
+}
+
+func (Backend) DeleteSync(sync uintptr) {
+	gl.DeleteSync(sync)
+}
+
+func (Backend) DeleteTextures(n int32, textures *uint32) {
+	gl.DeleteTextures(n, textures)
+}
+
+func (Backend) DeleteVertexArrays(n int32, arrays *uint32) {
+	gl.DeleteVertexArrays(n, arrays)
+}
+
+func (Backend) DepthFunc(xfunc uint32) {
+	gl.DepthFunc(xfunc)
+}
+
+func (Backend) DepthMask(flag bool) {
+	gl.DepthMask(flag)
+}
+
+func (Backend) DetachShader(program uint32, shader uint32) {
+	gl.DetachShader(program, shader)
+}
+
+func (Backend) Disable(cap uint32) {
+	gl.Disable(cap)
+}
+
+func (Backend) Disablei(target uint32, index uint32) {
+	gl.Disablei(target, index)
+}
+
+func (Backend) DispatchCompute(num_groups_x uint32, num_groups_y uint32, num_groups_z uint32) {
+	gl.DispatchCompute(num_groups_x, num_groups_y, num_groups_z)
+}
+
+func (Backend) DrawArrays(mode uint32, first int32, count int32) {
+	gl.DrawArrays(mode, first, count)
+}
+
+func (Backend) DrawArraysInstanced(mode uint32, first int32, count int32, instancecount int32) {
+	gl.DrawArraysInstanced(mode, first, count, instancecount)
+}
+
+func (Backend) DrawArraysInstancedBaseInstance(mode uint32, first int32, count int32, instancecount int32, baseinstance uint32) {
+	gl.DrawArraysInstancedBaseInstance(mode, first, count, instancecount, baseinstance)
+}
+
+func (Backend) DrawBuffers(n int32, bufs *uint32) {
+	gl.DrawBuffers(n, bufs)
+}
+
+func (Backend) DrawElementsInstanced(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32) {
+	gl.DrawElementsInstanced(mode, count, xtype, indices, instancecount)
+}
+
+func (Backend) DrawElementsInstancedBaseInstance(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32, baseinstance uint32) {
+	gl.DrawElementsInstancedBaseInstance(mode, count, xtype, indices, instancecount, baseinstance)
+}
+
+func (Backend) DrawElementsWithOffset(mode uint32, count int32, xtype uint32, indices uintptr) {
+	gl.DrawElementsWithOffset(mode, count, xtype, indices)
+}
+
+func (Backend) Enable(cap uint32) {
+	gl.Enable(cap)
+}
+
+func (Backend) EnableVertexAttribArray(index uint32) {
+	gl.EnableVertexAttribArray(index)
+}
+
+func (Backend) Enablei(target uint32, index uint32) {
+	gl.Enablei(target, index)
+}
+
+func (Backend) FenceSync(condition uint32, flags uint32) uintptr {
+	return gl.FenceSync(condition, flags)
+}
+
+func (Backend) FramebufferRenderbuffer(target uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32) {
+	gl.FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer)
+}
+
+func (Backend) FramebufferTexture2D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	gl.FramebufferTexture2D(target, attachment, textarget, texture, level)
+}
+
+func (Backend) FrontFace(mode uint32) {
+	gl.FrontFace(mode)
+}
+
+func (Backend) GenBuffers(n int32, buffers *uint32) {
+	gl.GenBuffers(n, buffers)
+}
+
+func (Backend) GenerateMipmap(target uint32) {
+	gl.GenerateMipmap(target)
+}
+
+func (Backend) GenFramebuffers(n int32, framebuffers *uint32) {
+	gl.GenFramebuffers(n, framebuffers)
+}
+
+func (Backend) GenRenderbuffers(n int32, renderbuffers *uint32) {
+	gl.GenRenderbuffers(n, renderbuffers)
+}
+
+func (Backend) GenTextures(n int32, textures *uint32) {
+	gl.GenTextures(n, textures)
+}
+
+func (Backend) GenVertexArrays(n int32, arrays *uint32) {
+	gl.GenVertexArrays(n, arrays)
+}
+
+func (Backend) GetAttribLocation(program uint32, name *uint8) int32 {
+	return gl.GetAttribLocation(program, name)
+}
+
+func (Backend) GetError() uint32 {
+	return gl.GetError()
+}
+
+func (Backend) GetIntegeri_v(target uint32, index uint32, data *int32) {
+	gl.GetIntegeri_v(target, index, data)
+}
+
+func (Backend) GetIntegerv(pname uint32, data *int32) {
+	gl.GetIntegerv(pname, data)
+}
+
+func (Backend) GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	gl.GetProgramInfoLog(program, bufSize, length, infoLog)
+}
+
+func (Backend) GetProgramiv(program uint32, pname uint32, params *int32) {
+	gl.GetProgramiv(program, pname, params)
+}
+
+func (Backend) GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	gl.GetShaderInfoLog(shader, bufSize, length, infoLog)
+}
+
+func (Backend) GetShaderiv(shader uint32, pname uint32, params *int32) {
+	gl.GetShaderiv(shader, pname, params)
+}
+
+func (Backend) GetString(name uint32) *uint8 {
+	return gl.GetString(name)
+}
+
+func (Backend) GetStringi(name uint32, index uint32) *uint8 {
+	return gl.GetStringi(name, index)
+}
+
+func (Backend) GetTexImage(target uint32, level int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	gl.GetTexImage(target, level, format, xtype, pixels)
+}
+
+func (Backend) GetUniformLocation(program uint32, name *uint8) int32 {
+	return gl.GetUniformLocation(program, name)
+}
+
+func (Backend) LinkProgram(program uint32) {
+	gl.LinkProgram(program)
+}
+
+func (Backend) MapBufferRange(target uint32, offset int, length int, access uint32) unsafe.Pointer {
+	return gl.MapBufferRange(target, offset, length, access)
+}
+
+func (Backend) MemoryBarrier(barriers uint32) {
+	gl.MemoryBarrier(barriers)
+}
+
+func (Backend) MultiDrawElementsIndirect(mode uint32, xtype uint32, indirect unsafe.Pointer, drawcount int32, stride int32) {
+	gl.MultiDrawElementsIndirect(mode, xtype, indirect, drawcount, stride)
+}
+
+func (Backend) ObjectLabel(identifier uint32, name uint32, length int32, label *uint8) {
+	gl.ObjectLabel(identifier, name, length, label)
+}
+
+func (Backend) PixelStorei(pname uint32, param int32) {
+	gl.PixelStorei(pname, param)
+}
+
+func (Backend) PolygonMode(face uint32, mode uint32) {
+	gl.PolygonMode(face, mode)
+}
+
+func (Backend) PopDebugGroup() {
+	gl.PopDebugGroup()
+}
+
+func (Backend) PushDebugGroup(source uint32, id uint32, length int32, message *uint8) {
+	gl.PushDebugGroup(source, id, length, message)
+}
+
+func (Backend) ReadBuffer(src uint32) {
+	gl.ReadBuffer(src)
+}
+
+func (Backend) ReadPixels(x int32, y int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	gl.ReadPixels(x, y, width, height, format, xtype, pixels)
+}
+
+func (Backend) RenderbufferStorage(target uint32, internalformat uint32, width int32, height int32) {
+	gl.RenderbufferStorage(target, internalformat, width, height)
+}
+
+func (Backend) Scissor(x int32, y int32, width int32, height int32) {
+	gl.Scissor(x, y, width, height)
+}
+
+func (Backend) StencilFuncSeparate(face uint32, xfunc uint32, ref int32, mask uint32) {
+	gl.StencilFuncSeparate(face, xfunc, ref, mask)
+}
+
+func (Backend) StencilMaskSeparate(face uint32, mask uint32) {
+	gl.StencilMaskSeparate(face, mask)
+}
+
+func (Backend) StencilOpSeparate(face uint32, sfail uint32, dpfail uint32, dppass uint32) {
+	gl.StencilOpSeparate(face, sfail, dpfail, dppass)
+}
+
+func (Backend) TexImage2D(target uint32, level int32, internalformat int32, width int32, height int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	gl.TexImage2D(target, level, internalformat, width, height, border, format, xtype, pixels)
+}
+
+func (Backend) TexParameteri(target uint32, pname uint32, param int32) {
+	gl.TexParameteri(target, pname, param)
+}
+
+func (Backend) Uniform1f(location int32, v0 float32) {
+	gl.Uniform1f(location, v0)
+}
+
+func (Backend) Uniform1i(location int32, v0 int32) {
+	gl.Uniform1i(location, v0)
+}
+
+func (Backend) Uniform2f(location int32, v0 float32, v1 float32) {
+	gl.Uniform2f(location, v0, v1)
+}
+
+func (Backend) Uniform3f(location int32, v0 float32, v1 float32, v2 float32) {
+	gl.Uniform3f(location, v0, v1, v2)
+}
+
+func (Backend) Uniform4f(location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	gl.Uniform4f(location, v0, v1, v2, v3)
+}
+
+func (Backend) UnmapBuffer(target uint32) bool {
+	return gl.UnmapBuffer(target)
+}
+
+func (Backend) UseProgram(program uint32) {
+	gl.UseProgram(program)
+}
+
+func (Backend) ValidateProgram(program uint32) {
+	gl.ValidateProgram(program)
+}
+
+func (Backend) VertexAttribDivisor(index uint32, divisor uint32) {
+	gl.VertexAttribDivisor(index, divisor)
+}
+
+func (Backend) VertexAttribPointerWithOffset(index uint32, size int32, xtype uint32, normalized bool, stride int32, offset uintptr) {
+	gl.VertexAttribPointerWithOffset(index, size, xtype, normalized, stride, offset)
+}
+
+func (Backend) Viewport(x int32, y int32, width int32, height int32) {
+	gl.Viewport(x, y, width, height)
+}
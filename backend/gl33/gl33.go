@@ -0,0 +1,30 @@
+// Package gl33 implements backend.Backend with the OpenGL 3.3 core
+// profile bindings of github.com/go-gl/gl. The bindings must be initialized
+// with gl.Init after creating the context, same as when using go-gl directly.
+package gl33
+
+import (
+	"unsafe"
+
+	"github.com/go-gl/gl/v3.3-core/gl"
+	"github.com/soypat/shaders/backend"
+)
+
+//go:generate go run ../../internal/cmd/genbackend -mode=adapter -iface ../backend.go -pkg github.com/go-gl/gl/v3.3-core/gl -o funcs.go
+
+// Backend forwards calls to the go-gl OpenGL 3.3 core bindings.
+type Backend struct{}
+
+var _ backend.Backend = Backend{}
+
+func (Backend) Version() (major, minor int, es bool) { return 3, 3, false }
+
+func (Backend) ShaderSource(shader uint32, source string) {
+	csources, free := gl.Strs(source)
+	gl.ShaderSource(shader, 1, csources, nil)
+	free()
+}
+
+func (Backend) DebugMessageCallback(callback backend.DebugProc, userParam unsafe.Pointer) {
+	gl.DebugMessageCallback(gl.DebugProc(callback), userParam)
+}
@@ -0,0 +1,55 @@
+// Package gles30 implements backend.Backend with the OpenGL ES 3.0
+// bindings of github.com/go-gl/gl. The bindings must be initialized
+// with gles2.Init after creating the context, same as when using go-gl directly.
+//
+// OpenGL ES lacks some desktop functions such as glPolygonMode and
+// glGetTexImage. Package shaders avoids calling them on ES contexts.
+package gles30
+
+import (
+	"unsafe"
+
+	gl "github.com/go-gl/gl/v3.0/gles2"
+	"github.com/soypat/shaders/backend"
+)
+
+//go:generate go run ../../internal/cmd/genbackend -mode=adapter -iface ../backend.go -pkg github.com/go-gl/gl/v3.0/gles2 -o funcs.go
+
+// Backend forwards calls to the go-gl OpenGL ES 3.0 bindings.
+type Backend struct{}
+
+var _ backend.Backend = Backend{}
+
+func (Backend) Version() (major, minor int, es bool) { return 3, 0, true }
+
+func (Backend) ShaderSource(shader uint32, source string) {
+	csources, free := gl.Strs(source)
+	gl.ShaderSource(shader, 1, csources, nil)
+	free()
+}
+
+func (Backend) ClearDepth(depth float64) {
+	gl.ClearDepthf(float32(depth))
+}
+
+// KHR_debug functions are only exposed with the KHR suffix before OpenGL ES 3.2.
+
+func (Backend) DebugMessageCallback(callback backend.DebugProc, userParam unsafe.Pointer) {
+	gl.DebugMessageCallbackKHR(gl.DebugProc(callback), userParam)
+}
+
+func (Backend) DebugMessageControl(source uint32, xtype uint32, severity uint32, count int32, ids *uint32, enabled bool) {
+	gl.DebugMessageControlKHR(source, xtype, severity, count, ids, enabled)
+}
+
+func (Backend) ObjectLabel(identifier uint32, name uint32, length int32, label *uint8) {
+	gl.ObjectLabelKHR(identifier, name, length, label)
+}
+
+func (Backend) PushDebugGroup(source uint32, id uint32, length int32, message *uint8) {
+	gl.PushDebugGroupKHR(source, id, length, message)
+}
+
+func (Backend) PopDebugGroup() {
+	gl.PopDebugGroupKHR()
+}
@@ -0,0 +1,7 @@
+//go:build gl33
+
+package shaders
+
+import "github.com/soypat/shaders/backend/gl33"
+
+var defaultBackend = gl33.Backend{}
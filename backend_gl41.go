@@ -0,0 +1,7 @@
+//go:build gl41
+
+package shaders
+
+import "github.com/soypat/shaders/backend/gl41"
+
+var defaultBackend = gl41.Backend{}
@@ -0,0 +1,7 @@
+//go:build !gl33 && !gl41 && !gles30
+
+package shaders
+
+import "github.com/soypat/shaders/backend/gl46"
+
+var defaultBackend = gl46.Backend{}
@@ -0,0 +1,7 @@
+//go:build gles30
+
+package shaders
+
+import "github.com/soypat/shaders/backend/gles30"
+
+var defaultBackend = gles30.Backend{}
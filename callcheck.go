@@ -0,0 +1,78 @@
+package shaders
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/slog"
+)
+
+// CallError is returned by package functions when built with the gldebug tag
+// and an OpenGL call made on behalf of the caller sets an error flag.
+// Without the tag errors are only checked at the end of each function and
+// returned as GLErrors with no call information.
+type CallError struct {
+	// Func is the OpenGL function that raised the error, i.e: "glBufferData".
+	Func string
+	// Caller is the file:line of the code that called into the package.
+	Caller string
+	Err    GLErrors
+}
+
+func (e *CallError) Error() string {
+	return e.Caller + ": " + e.Func + ": " + e.Err.Error()
+}
+
+func (e *CallError) Unwrap() error { return e.Err }
+
+// checkCall returns a CallError if the OpenGL call fn raised an error.
+// It always returns nil unless built with the gldebug tag.
+func checkCall(fn string) error {
+	if !debugGL {
+		return nil
+	}
+	err := glCheckError()
+	if err == nil {
+		return nil
+	}
+	return &CallError{Func: fn, Caller: callSite(), Err: err.(GLErrors)}
+}
+
+// logCall is checkCall for functions that do not return an error,
+// i.e: Bind methods. The error is logged to slog.Default().
+func logCall(fn string) {
+	if !debugGL {
+		return
+	}
+	if err := checkCall(fn); err != nil {
+		slog.Error("OpenGL call failed", err)
+	}
+}
+
+// callSite returns the file:line of the first caller outside of the package.
+func callSite() string {
+	const pkgPrefix = "github.com/soypat/shaders."
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, pkgPrefix) {
+			return frame.File + ":" + strconv.Itoa(frame.Line)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+// checkErrorAfter is used after the last OpenGL call of a function. It returns
+// the errors raised by fn as a CallError when built with the gldebug tag.
+// In release builds it returns the errors raised by any call, same as glCheckError.
+func checkErrorAfter(fn string) error {
+	if debugGL {
+		return checkCall(fn)
+	}
+	return glCheckError()
+}
@@ -0,0 +1,152 @@
+package shaders
+
+import (
+	"sort"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// GLCapabilities describes the current OpenGL context. It is meant for choosing
+// code paths at runtime and is marshalled to JSON for bug reports.
+//
+//	caps := shaders.Capabilities()
+//	if caps.HasExtension("GL_ARB_bindless_texture") {
+//		// ...
+//	}
+type GLCapabilities struct {
+	// Vendor and Renderer identify the driver and device, i.e: "Mesa/X.org", "llvmpipe (LLVM 15.0.6, 256 bits)".
+	Vendor   string `json:"vendor"`
+	Renderer string `json:"renderer"`
+	// Version and GLSLVersion are the version strings reported by the driver,
+	// i.e: "4.5 (Core Profile) Mesa 22.3.6" and "4.50".
+	Version     string `json:"version"`
+	GLSLVersion string `json:"glslVersion"`
+	// Major and Minor is the context version. ES is true for OpenGL ES contexts.
+	Major int  `json:"major"`
+	Minor int  `json:"minor"`
+	ES    bool `json:"es"`
+	// Extensions are sorted by name.
+	Extensions []string `json:"extensions"`
+	Limits     GLLimits `json:"limits"`
+}
+
+// GLLimits are implementation dependent limits of an OpenGL context. Limits
+// of features the context lacks are zero, i.e: compute limits before OpenGL 4.3.
+type GLLimits struct {
+	MaxTextureSize               int    `json:"maxTextureSize"`
+	MaxRenderbufferSize          int    `json:"maxRenderbufferSize"`
+	MaxViewportDims              [2]int `json:"maxViewportDims"`
+	MaxSamples                   int    `json:"maxSamples"`
+	MaxColorAttachments          int    `json:"maxColorAttachments"`
+	MaxDrawBuffers               int    `json:"maxDrawBuffers"`
+	MaxVertexAttribs             int    `json:"maxVertexAttribs"`
+	MaxTextureImageUnits         int    `json:"maxTextureImageUnits"`
+	MaxCombinedTextureImageUnits int    `json:"maxCombinedTextureImageUnits"`
+	MaxVertexUniformComponents   int    `json:"maxVertexUniformComponents"`
+	MaxFragmentUniformComponents int    `json:"maxFragmentUniformComponents"`
+	// MaxUniformBlockSize is in bytes.
+	MaxUniformBlockSize      int `json:"maxUniformBlockSize"`
+	MaxUniformBufferBindings int `json:"maxUniformBufferBindings"`
+	// MaxShaderStorageBlockSize is in bytes, clamped to the range of an int32.
+	MaxShaderStorageBlockSize      int    `json:"maxShaderStorageBlockSize"`
+	MaxShaderStorageBufferBindings int    `json:"maxShaderStorageBufferBindings"`
+	MaxComputeWorkGroupCount       [3]int `json:"maxComputeWorkGroupCount"`
+	MaxComputeWorkGroupSize        [3]int `json:"maxComputeWorkGroupSize"`
+	MaxComputeWorkGroupInvocations int    `json:"maxComputeWorkGroupInvocations"`
+}
+
+// Capabilities queries the capabilities of the current OpenGL context.
+// Pending OpenGL errors are cleared.
+func Capabilities() GLCapabilities {
+	glClearError()
+	v := queryVersion()
+	caps := GLCapabilities{
+		Vendor:      gl.GoStr(gl.GetString(gl.VENDOR)),
+		Renderer:    gl.GoStr(gl.GetString(gl.RENDERER)),
+		Version:     gl.GoStr(gl.GetString(gl.VERSION)),
+		GLSLVersion: gl.GoStr(gl.GetString(gl.SHADING_LANGUAGE_VERSION)),
+		Major:       v.major,
+		Minor:       v.minor,
+		ES:          v.es,
+		Extensions:  extensions(),
+	}
+	setContextInfo(v, caps.Extensions)
+	l := &caps.Limits
+	l.MaxTextureSize = getInteger(gl.MAX_TEXTURE_SIZE)
+	l.MaxRenderbufferSize = getInteger(gl.MAX_RENDERBUFFER_SIZE)
+	var dims [2]int32
+	gl.GetIntegerv(gl.MAX_VIEWPORT_DIMS, &dims[0])
+	l.MaxViewportDims = [2]int{int(dims[0]), int(dims[1])}
+	l.MaxSamples = getInteger(gl.MAX_SAMPLES)
+	l.MaxColorAttachments = getInteger(gl.MAX_COLOR_ATTACHMENTS)
+	l.MaxDrawBuffers = getInteger(gl.MAX_DRAW_BUFFERS)
+	l.MaxVertexAttribs = getInteger(gl.MAX_VERTEX_ATTRIBS)
+	l.MaxTextureImageUnits = getInteger(gl.MAX_TEXTURE_IMAGE_UNITS)
+	l.MaxCombinedTextureImageUnits = getInteger(gl.MAX_COMBINED_TEXTURE_IMAGE_UNITS)
+	l.MaxVertexUniformComponents = getInteger(gl.MAX_VERTEX_UNIFORM_COMPONENTS)
+	l.MaxFragmentUniformComponents = getInteger(gl.MAX_FRAGMENT_UNIFORM_COMPONENTS)
+	l.MaxUniformBlockSize = getInteger(gl.MAX_UNIFORM_BLOCK_SIZE)
+	l.MaxUniformBufferBindings = getInteger(gl.MAX_UNIFORM_BUFFER_BINDINGS)
+	if caps.supports(4, 3, 3, 1, "GL_ARB_shader_storage_buffer_object") {
+		l.MaxShaderStorageBlockSize = getInteger(gl.MAX_SHADER_STORAGE_BLOCK_SIZE)
+		l.MaxShaderStorageBufferBindings = getInteger(gl.MAX_SHADER_STORAGE_BUFFER_BINDINGS)
+	}
+	if caps.supports(4, 3, 3, 1, "GL_ARB_compute_shader") {
+		for i := range l.MaxComputeWorkGroupCount {
+			var count, size int32
+			gl.GetIntegeri_v(gl.MAX_COMPUTE_WORK_GROUP_COUNT, uint32(i), &count)
+			gl.GetIntegeri_v(gl.MAX_COMPUTE_WORK_GROUP_SIZE, uint32(i), &size)
+			l.MaxComputeWorkGroupCount[i] = int(count)
+			l.MaxComputeWorkGroupSize[i] = int(size)
+		}
+		l.MaxComputeWorkGroupInvocations = getInteger(gl.MAX_COMPUTE_WORK_GROUP_INVOCATIONS)
+	}
+	// Limits unknown to old drivers flag errors, they are left as zero.
+	glClearError()
+	return caps
+}
+
+// HasExtension reports whether the extension ext is in the report, i.e: "GL_KHR_debug".
+func (caps *GLCapabilities) HasExtension(ext string) bool {
+	i := sort.SearchStrings(caps.Extensions, ext)
+	return i < len(caps.Extensions) && caps.Extensions[i] == ext
+}
+
+// AtLeast reports whether the context is at least version major.minor.
+// For OpenGL ES contexts the version is compared with the OpenGL ES version.
+func (caps *GLCapabilities) AtLeast(major, minor int) bool {
+	return glVersion{major: caps.Major, minor: caps.Minor}.atLeast(major, minor)
+}
+
+// supports reports whether the context is at least OpenGL major.minor, OpenGL ES
+// esMajor.esMinor for ES contexts, or exposes the extension ext.
+func (caps *GLCapabilities) supports(major, minor, esMajor, esMinor int, ext string) bool {
+	if caps.ES {
+		major, minor = esMajor, esMinor
+	}
+	return caps.AtLeast(major, minor) || caps.HasExtension(ext)
+}
+
+// HasExtension reports whether the current context exposes the extension ext.
+// Extensions are queried once per context, see InvalidateContext.
+func HasExtension(ext string) bool {
+	return currentContext().extensions[ext]
+}
+
+// extensions returns the sorted extensions of the current context.
+func extensions() []string {
+	var n int32
+	gl.GetIntegerv(gl.NUM_EXTENSIONS, &n)
+	exts := make([]string, n)
+	for i := range exts {
+		exts[i] = gl.GoStr(gl.GetStringi(gl.EXTENSIONS, uint32(i)))
+	}
+	sort.Strings(exts)
+	return exts
+}
+
+func getInteger(pname uint32) int {
+	var v int32
+	gl.GetIntegerv(pname, &v)
+	return int(v)
+}
@@ -0,0 +1,127 @@
+// Command glinfo prints what the OpenGL driver supports: vendor, versions,
+// limits, extensions and which shader stages and GLSL versions compile.
+// It creates a hidden GLFW window for the context, or with -headless
+// an EGL context which needs no display.
+//
+//	go run ./cmd/glinfo -json > glinfo.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"runtime"
+	"text/tabwriter"
+	"unsafe"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+	"github.com/soypat/shaders"
+	"github.com/soypat/shaders/headless"
+	"github.com/soypat/shaders/internal/bindings"
+)
+
+func init() {
+	// GLFW event handling must run on the main OS thread
+	runtime.LockOSThread()
+}
+
+// report is the output of glinfo.
+type report struct {
+	shaders.GLCapabilities
+	ShaderStages []string `json:"shaderStages"`
+	GLSLVersions []string `json:"glslVersions"`
+}
+
+func main() {
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	es := flag.Bool("es", false, "create an OpenGL ES 3.0 context instead of a desktop core profile one")
+	useHeadless := flag.Bool("headless", false, "create a headless EGL context instead of a hidden GLFW window")
+	flag.Parse()
+	if err := run(os.Stdout, *asJSON, *es, *useHeadless); err != nil {
+		fmt.Fprintln(os.Stderr, "glinfo:", err)
+		os.Exit(1)
+	}
+}
+
+func run(w io.Writer, asJSON, es, useHeadless bool) error {
+	getProcAddr, destroy, err := createContext(es, useHeadless)
+	if err != nil {
+		return err
+	}
+	defer destroy()
+	if err := bindings.Init(es, getProcAddr); err != nil {
+		return err
+	}
+	shaders.ClearErrors()
+
+	r := report{GLCapabilities: shaders.Capabilities()}
+	r.GLSLVersions = probeGLSLVersions()
+	r.ShaderStages = probeStages(r.GLSLVersions)
+	if asJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "\t")
+		return enc.Encode(r)
+	}
+	return writeText(w, &r)
+}
+
+// createContext makes a context current and returns the function
+// with which to look up its OpenGL functions.
+func createContext(es, useHeadless bool) (getProcAddr func(string) unsafe.Pointer, destroy func(), err error) {
+	if useHeadless {
+		ctx, err := headless.New(headless.Config{ES: es})
+		if err != nil {
+			return nil, nil, err
+		}
+		return ctx.ProcAddress, ctx.Destroy, nil
+	}
+	if err := glfw.Init(); err != nil {
+		return nil, nil, err
+	}
+	glfw.WindowHint(glfw.Visible, glfw.False)
+	if es {
+		glfw.WindowHint(glfw.ClientAPI, glfw.OpenGLESAPI)
+		glfw.WindowHint(glfw.ContextVersionMajor, 3)
+		glfw.WindowHint(glfw.ContextVersionMinor, 0)
+	} else {
+		// Drivers return the newest version compatible with the one requested.
+		glfw.WindowHint(glfw.ContextVersionMajor, 3)
+		glfw.WindowHint(glfw.ContextVersionMinor, 3)
+		glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
+		glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
+	}
+	window, err := glfw.CreateWindow(64, 64, "glinfo", nil, nil)
+	if err != nil {
+		glfw.Terminate()
+		return nil, nil, err
+	}
+	window.MakeContextCurrent()
+	destroy = func() {
+		window.Destroy()
+		glfw.Terminate()
+	}
+	return glfw.GetProcAddress, destroy, nil
+}
+
+func writeText(w io.Writer, r *report) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Vendor:\t%s\n", r.Vendor)
+	fmt.Fprintf(tw, "Renderer:\t%s\n", r.Renderer)
+	fmt.Fprintf(tw, "Version:\t%s\n", r.Version)
+	fmt.Fprintf(tw, "GLSL version:\t%s\n", r.GLSLVersion)
+	fmt.Fprintf(tw, "GLSL versions compiled:\t%v\n", r.GLSLVersions)
+	fmt.Fprintf(tw, "Shader stages:\t%v\n", r.ShaderStages)
+	fmt.Fprintln(tw, "\nLimits:")
+	limits := reflect.ValueOf(r.Limits)
+	for i := 0; i < limits.NumField(); i++ {
+		fmt.Fprintf(tw, "  %s\t%v\n", limits.Type().Field(i).Name, limits.Field(i).Interface())
+	}
+	fmt.Fprintf(tw, "\nExtensions (%d):\n", len(r.Extensions))
+	for _, ext := range r.Extensions {
+		fmt.Fprintf(tw, "  %s\n", ext)
+	}
+	return tw.Flush()
+}
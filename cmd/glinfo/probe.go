@@ -0,0 +1,136 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/soypat/shaders"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// glslVersions are the #version directive arguments probed, oldest first.
+var glslVersions = []string{
+	"110", "120", "130", "140", "150", "330", "400", "410", "420", "430", "440", "450", "460",
+	"100", "300 es", "310 es", "320 es",
+}
+
+// probeGLSLVersions returns the GLSL versions for which a trivial
+// vertex and fragment shader program compiles and links.
+func probeGLSLVersions() (supported []string) {
+	shaders.SetVersionAdaptation(false)
+	defer shaders.SetVersionAdaptation(true)
+	for _, version := range glslVersions {
+		if compiles(version) {
+			supported = append(supported, version)
+		}
+	}
+	return supported
+}
+
+func compiles(version string) bool {
+	header := "#version " + version + "\n"
+	vertex := header + "void main() { gl_Position = vec4(0.0); }\n\x00"
+	var fragment string
+	if strings.HasSuffix(version, " es") || version == "100" {
+		header += "precision mediump float;\n"
+	}
+	if n, _ := strconv.Atoi(strings.TrimSuffix(version, " es")); n < 130 {
+		fragment = header + "void main() { gl_FragColor = vec4(1.0); }\n\x00"
+	} else {
+		fragment = header + "out vec4 color;\nvoid main() { color = vec4(1.0); }\n\x00"
+	}
+	prog, err := shaders.NewProgram(shaders.ShaderSource{Vertex: vertex, Fragment: fragment})
+	if err != nil {
+		return false
+	}
+	prog.Delete()
+	return true
+}
+
+// stage is the body of a minimal shader of a stage, without #version directive.
+type stage struct {
+	shaderType uint32
+	body       string
+}
+
+var (
+	vertexStage   = stage{gl.VERTEX_SHADER, "void main() { gl_Position = vec4(0.0); }\n"}
+	fragmentStage = stage{gl.FRAGMENT_SHADER, "out vec4 color;\nvoid main() { color = vec4(1.0); }\n"}
+	geometryStage = stage{gl.GEOMETRY_SHADER, `layout(points) in;
+layout(points, max_vertices = 1) out;
+void main() { gl_Position = gl_in[0].gl_Position; EmitVertex(); }
+`}
+	tessControlStage = stage{gl.TESS_CONTROL_SHADER, `layout(vertices = 1) out;
+void main() {
+	gl_out[gl_InvocationID].gl_Position = gl_in[gl_InvocationID].gl_Position;
+	gl_TessLevelOuter[0] = 1.0;
+	gl_TessLevelOuter[1] = 1.0;
+}
+`}
+	tessEvaluationStage = stage{gl.TESS_EVALUATION_SHADER, "layout(isolines) in;\nvoid main() { gl_Position = gl_in[0].gl_Position; }\n"}
+	computeStage        = stage{gl.COMPUTE_SHADER, "layout(local_size_x = 1) in;\nvoid main() {}\n"}
+)
+
+// probeStages returns the shader stages with which a minimal program links.
+// supported are the GLSL versions returned by probeGLSLVersions.
+func probeStages(supported []string) (stages []string) {
+	// probeGLSLVersions links vertex and fragment shader programs.
+	if len(supported) > 0 {
+		stages = append(stages, "vertex", "fragment")
+	}
+	probes := []struct {
+		name string
+		// versions are the oldest desktop and ES GLSL versions with the stage.
+		versions [2]string
+		stages   []stage
+	}{
+		{"geometry", [2]string{"150", "320 es"}, []stage{vertexStage, geometryStage, fragmentStage}},
+		{"tessellation", [2]string{"400", "320 es"}, []stage{vertexStage, tessControlStage, tessEvaluationStage, fragmentStage}},
+		{"compute", [2]string{"430", "310 es"}, []stage{computeStage}},
+	}
+	for _, probe := range probes {
+		for _, version := range probe.versions {
+			if contains(supported, version) && links(version, probe.stages...) {
+				stages = append(stages, probe.name)
+				break
+			}
+		}
+	}
+	// Shader types unknown to the context set GL_INVALID_ENUM.
+	shaders.ClearErrors()
+	return stages
+}
+
+// links reports whether a program of the stages with a #version version directive links.
+func links(version string, stages ...stage) bool {
+	header := "#version " + version + "\n"
+	if strings.HasSuffix(version, " es") {
+		header += "precision mediump float;\n"
+	}
+	program := gl.CreateProgram()
+	defer gl.DeleteProgram(program)
+	for _, s := range stages {
+		shader := gl.CreateShader(s.shaderType)
+		if shader == 0 {
+			return false
+		}
+		gl.ShaderSource(shader, header+s.body+"\x00")
+		gl.CompileShader(shader)
+		gl.AttachShader(program, shader)
+		// Flagged for deletion, it is deleted with the program.
+		gl.DeleteShader(shader)
+	}
+	gl.LinkProgram(program)
+	var status int32
+	gl.GetProgramiv(program, gl.LINK_STATUS, &status)
+	return status == gl.TRUE
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package shaders
+
+import (
+	"strconv"
+	"unsafe"
+
+	"github.com/soypat/shaders/internal/gl"
+	"golang.org/x/exp/slog"
+)
+
+// EnableDebugOutput installs an OpenGL debug message callback that routes driver
+// messages to logger. Message severity maps to log levels: high to error, medium to
+// warn, low to info and notifications to debug. Messages are delivered synchronously
+// so they are logged during the offending OpenGL call. Filters are applied in order,
+// see SetDebugFilter. If logger is nil slog.Default() is used.
+//
+// The richest messages are emitted by debug contexts which must be requested on context
+// creation, i.e: with GLFW call glfw.WindowHint(glfw.OpenGLDebugContext, glfw.True).
+// It returns ErrUnsupported if the context is older than OpenGL 4.3 or OpenGL ES 3.2 and lacks KHR_debug.
+func EnableDebugOutput(logger *slog.Logger, filters ...DebugFilter) error {
+	if !hasKHRDebug() {
+		return ErrUnsupported
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	gl.Enable(gl.DEBUG_OUTPUT)
+	gl.Enable(gl.DEBUG_OUTPUT_SYNCHRONOUS)
+	gl.DebugMessageCallback(func(source, gltype, id, severity uint32, length int32, message string, userParam unsafe.Pointer) {
+		logger.Log(debugSeverityLevel(severity), message,
+			"source", debugSourceName(source),
+			"type", debugTypeName(gltype),
+			"id", id,
+			"severity", debugSeverityName(severity),
+		)
+	}, nil)
+	for _, f := range filters {
+		SetDebugFilter(f)
+	}
+	return glCheckError()
+}
+
+// DisableDebugOutput stops delivery of debug messages.
+func DisableDebugOutput() {
+	if !hasKHRDebug() {
+		return
+	}
+	gl.Disable(gl.DEBUG_OUTPUT)
+	gl.Disable(gl.DEBUG_OUTPUT_SYNCHRONOUS)
+}
+
+// DebugFilter selects debug messages to be enabled or disabled. A zero Source, Type
+// or Severity matches messages with any value, same as gl.DONT_CARE.
+type DebugFilter struct {
+	// Source of messages, i.e: gl.DEBUG_SOURCE_API, gl.DEBUG_SOURCE_SHADER_COMPILER.
+	Source uint32
+	// Type of messages, i.e: gl.DEBUG_TYPE_ERROR, gl.DEBUG_TYPE_PERFORMANCE.
+	Type uint32
+	// Severity of messages, i.e: gl.DEBUG_SEVERITY_NOTIFICATION. Must be zero if IDs is set.
+	Severity uint32
+	// IDs restricts the filter to the messages with these ids.
+	IDs []uint32
+	// Enable enables matching messages if true and disables them if false.
+	Enable bool
+}
+
+// SetDebugFilter enables or disables debug messages matching the filter.
+// Messages with low severity are disabled by default by some drivers.
+//
+//	// Silence buffer usage hints.
+//	shaders.SetDebugFilter(shaders.DebugFilter{Severity: gl.DEBUG_SEVERITY_NOTIFICATION})
+func SetDebugFilter(f DebugFilter) {
+	if !hasKHRDebug() {
+		return
+	}
+	dontCare := func(v uint32) uint32 {
+		if v == 0 {
+			return gl.DONT_CARE
+		}
+		return v
+	}
+	var ids *uint32
+	if len(f.IDs) > 0 {
+		ids = &f.IDs[0]
+	}
+	gl.DebugMessageControl(dontCare(f.Source), dontCare(f.Type), dontCare(f.Severity), int32(len(f.IDs)), ids, f.Enable)
+}
+
+// PushDebugGroup marks the start of a group of commands, i.e: a render pass.
+// Groups appear in debug messages and in graphics debuggers such as RenderDoc.
+// It is a no-op if the context lacks KHR_debug.
+//
+//	shaders.PushDebugGroup("bloom")
+//	defer shaders.PopDebugGroup()
+func PushDebugGroup(name string) {
+	if !hasKHRDebug() {
+		return
+	}
+	gl.PushDebugGroup(gl.DEBUG_SOURCE_APPLICATION, 0, int32(len(name)), gl.Str(name+"\x00"))
+}
+
+// PopDebugGroup marks the end of the group started by the last PushDebugGroup.
+func PopDebugGroup() {
+	if !hasKHRDebug() {
+		return
+	}
+	gl.PopDebugGroup()
+}
+
+func (vbo VertexBuffer) SetLabel(label string) error   { return setLabel(gl.BUFFER, vbo.rid, label) }
+func (vbo IndexBuffer) SetLabel(label string) error    { return setLabel(gl.BUFFER, vbo.rid, label) }
+func (buf IndirectBuffer) SetLabel(label string) error { return setLabel(gl.BUFFER, buf.rid, label) }
+func (vao VertexArray) SetLabel(label string) error    { return setLabel(gl.VERTEX_ARRAY, vao.rid, label) }
+func (p Program) SetLabel(label string) error          { return setLabel(gl.PROGRAM, p.rid, label) }
+func (t Texture) SetLabel(label string) error          { return setLabel(gl.TEXTURE, t.rid, label) }
+func (rb Renderbuffer) SetLabel(label string) error    { return setLabel(gl.RENDERBUFFER, rb.rid, label) }
+
+// SetLabel labels the framebuffer. Its attachments are labeled
+// with the label and the attachment name as suffix.
+func (fb *Framebuffer) SetLabel(label string) error {
+	for i, tex := range fb.color {
+		if err := tex.SetLabel(label + ".color" + strconv.Itoa(i)); err != nil {
+			return err
+		}
+	}
+	if fb.depthTex.rid != 0 {
+		if err := fb.depthTex.SetLabel(label + ".depth"); err != nil {
+			return err
+		}
+	}
+	if fb.depthRB.rid != 0 {
+		if err := fb.depthRB.SetLabel(label + ".depth"); err != nil {
+			return err
+		}
+	}
+	return setLabel(gl.FRAMEBUFFER, fb.rid, label)
+}
+
+// setLabel names an OpenGL object so that debug messages and graphics debuggers
+// refer to it by label instead of by id.
+func setLabel(identifier, name uint32, label string) error {
+	if !hasKHRDebug() {
+		return ErrUnsupported
+	}
+	gl.ObjectLabel(identifier, name, int32(len(label)), gl.Str(label+"\x00"))
+	return glCheckError()
+}
+
+func hasKHRDebug() bool {
+	return contextSupports(4, 3, "GL_KHR_debug") || contextSupportsES(3, 2, "")
+}
+
+func debugSeverityLevel(severity uint32) slog.Level {
+	switch severity {
+	case gl.DEBUG_SEVERITY_HIGH:
+		return slog.LevelError
+	case gl.DEBUG_SEVERITY_MEDIUM:
+		return slog.LevelWarn
+	case gl.DEBUG_SEVERITY_LOW:
+		return slog.LevelInfo
+	}
+	return slog.LevelDebug
+}
+
+func debugSourceName(source uint32) string     { return enumLabel(source, "DEBUG_SOURCE_") }
+func debugTypeName(gltype uint32) string       { return enumLabel(gltype, "DEBUG_TYPE_") }
+func debugSeverityName(severity uint32) string { return enumLabel(severity, "DEBUG_SEVERITY_") }
@@ -0,0 +1,58 @@
+package shaders
+
+import (
+	"fmt"
+	"strings"
+)
+
+//go:generate go run ./internal/cmd/genenums -o enumnames.go
+
+// EnumName returns the name of the OpenGL enum with value v, i.e: "GL_FLOAT".
+// Many enums share a value, i.e: GL_NONE and GL_ZERO, in which case the first
+// name in alphabetical order is returned. Use EnumNameWithPrefix when the kind of enum
+// is known. Values with no name are formatted as hexadecimal.
+func EnumName(v uint32) string {
+	names := enumNames[v]
+	if len(names) == 0 {
+		return fmt.Sprintf("0x%04X", v)
+	}
+	return "GL_" + names[0]
+}
+
+// EnumNames returns the names of all OpenGL enums with value v.
+func EnumNames(v uint32) []string {
+	names := make([]string, len(enumNames[v]))
+	for i, name := range enumNames[v] {
+		names[i] = "GL_" + name
+	}
+	return names
+}
+
+// EnumNameWithPrefix returns the name of the OpenGL enum with value v that
+// starts with prefix. It is useful to disambiguate enums sharing a value:
+//
+//	shaders.EnumNameWithPrefix(0, "GL_NO_") // GL_NO_ERROR
+//
+// If no enum name matches the result of EnumName is returned.
+func EnumNameWithPrefix(v uint32, prefix string) string {
+	short := strings.TrimPrefix(prefix, "GL_")
+	for _, name := range enumNames[v] {
+		if strings.HasPrefix(name, short) {
+			return "GL_" + name
+		}
+	}
+	return EnumName(v)
+}
+
+// enumLabel returns a lower case, human readable description of the enum
+// with value v whose name starts with prefix, with the prefix removed.
+// For example enumLabel(gl.DEBUG_SOURCE_WINDOW_SYSTEM, "DEBUG_SOURCE_") returns "window system".
+// It returns "unknown" if no enum matches.
+func enumLabel(v uint32, prefix string) string {
+	for _, name := range enumNames[v] {
+		if strings.HasPrefix(name, prefix) {
+			return strings.ToLower(strings.ReplaceAll(name[len(prefix):], "_", " "))
+		}
+	}
+	return "unknown"
+}
@@ -57,6 +57,7 @@ func main() {
 	glfw.WindowHint(glfw.ContextVersionMinor, 1)
 	glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
 	glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
+	glfw.WindowHint(glfw.OpenGLDebugContext, glfw.True)
 	window, err := glfw.CreateWindow(windowWidth, windowHeight, projectName, nil, nil)
 	if err != nil {
 		slog.Error("create glfw window failed", err)
@@ -69,15 +70,10 @@ func main() {
 		return
 	}
 	glClearError()
-	// gl.DebugMessageCallback(func(source, gltype, id, severity uint32, length int32, message string, userParam unsafe.Pointer) {
-	// 	slog.Warn(message,
-	// 		slog.Uint64("source", uint64(source)),
-	// 		slog.Uint64("gltype", uint64(gltype)),
-	// 		slog.Uint64("id", uint64(id)),
-	// 		slog.Uint64("severity", uint64(severity)),
-	// 		slog.Int("length", int(length)),
-	// 	)
-	// }, unsafe.Pointer(nil))
+	// Driver debug messages are more descriptive than glGetError codes.
+	if err := shaders.EnableDebugOutput(slog.Default()); err != nil {
+		slog.Warn("debug output unavailable", "err", err)
+	}
 	version := gl.GoStr(gl.GetString(gl.VERSION))
 	fmt.Println("OpenGL version", version)
 
@@ -157,7 +153,7 @@ func glCheckError() error {
 	if code == gl.NO_ERROR {
 		return nil
 	}
-	errs := GLErrors{code}
+	errs := shaders.GLErrors{code}
 	for {
 		code = gl.GetError()
 		if code == gl.NO_ERROR {
@@ -166,33 +162,3 @@ func glCheckError() error {
 		errs = append(errs, code)
 	}
 }
-
-type GLErrors []uint32
-
-func (ge GLErrors) Error() (errstr string) {
-	if len(ge) == 0 {
-		return "no gl errors"
-	}
-	for i := range ge {
-		var s string
-		switch ge[i] {
-		case gl.INVALID_ENUM:
-			s = "invalid enum"
-		case gl.INVALID_FRAMEBUFFER_OPERATION:
-			s = "invalid framebuffer operation"
-		case gl.INVALID_INDEX:
-			s = "invalid index"
-		case gl.INVALID_OPERATION:
-			s = "invalid operation"
-		case gl.INVALID_VALUE:
-			s = "invalid value"
-		default:
-			s = "unknown error enum"
-		}
-		errstr += s
-		if i != len(ge)-1 {
-			errstr += "; "
-		}
-	}
-	return errstr
-}
@@ -172,7 +172,7 @@ func glCheckError() error {
 	if code == gl.NO_ERROR {
 		return nil
 	}
-	errs := GLErrors{code}
+	errs := shaders.GLErrors{code}
 	for {
 		code = gl.GetError()
 		if code == gl.NO_ERROR {
@@ -181,33 +181,3 @@ func glCheckError() error {
 		errs = append(errs, code)
 	}
 }
-
-type GLErrors []uint32
-
-func (ge GLErrors) Error() (errstr string) {
-	if len(ge) == 0 {
-		return "no gl errors"
-	}
-	for i := range ge {
-		var s string
-		switch ge[i] {
-		case gl.INVALID_ENUM:
-			s = "invalid enum"
-		case gl.INVALID_FRAMEBUFFER_OPERATION:
-			s = "invalid framebuffer operation"
-		case gl.INVALID_INDEX:
-			s = "invalid index"
-		case gl.INVALID_OPERATION:
-			s = "invalid operation"
-		case gl.INVALID_VALUE:
-			s = "invalid value"
-		default:
-			s = "unknown error enum"
-		}
-		errstr += s
-		if i != len(ge)-1 {
-			errstr += "; "
-		}
-	}
-	return errstr
-}
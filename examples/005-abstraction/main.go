@@ -3,16 +3,14 @@ package main
 import (
 	_ "embed"
 	"fmt"
+	"image/color"
 	_ "image/png"
 	"os"
-	"runtime"
 	"strings"
-	"time"
-	"unsafe"
 
 	"github.com/go-gl/gl/v4.6-core/gl"
-	"github.com/go-gl/glfw/v3.3/glfw"
 	"github.com/soypat/shaders"
+	"github.com/soypat/shaders/app"
 	"golang.org/x/exp/slog"
 )
 
@@ -23,11 +21,6 @@ const (
 	windowHeight = 800
 )
 
-func init() {
-	// GLFW event handling must run on the main OS thread
-	runtime.LockOSThread()
-}
-
 //go:embed uniformtriangle.glsl
 var shader string
 
@@ -47,32 +40,13 @@ var indices = []uint32{
 }
 
 func main() {
-	if err := glfw.Init(); err != nil {
-		slog.Error("failed to initialize glfw", err)
-		os.Exit(1)
-	}
-	defer glfw.Terminate()
-
-	glfw.WindowHint(glfw.Resizable, glfw.False)
-	glfw.WindowHint(glfw.ContextVersionMajor, 4)
-	glfw.WindowHint(glfw.ContextVersionMinor, 1)
-	glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
-	glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
-	window, err := glfw.CreateWindow(windowWidth, windowHeight, projectName, nil, nil)
+	a, err := app.New(app.Config{Width: windowWidth, Height: windowHeight, Title: projectName})
 	if err != nil {
-		slog.Error("create glfw window failed", err)
-		return
-	}
-	window.MakeContextCurrent()
-	// Initialize Glow
-	if err := gl.Init(); err != nil {
-		slog.Error("init glow fail", err)
-		return
+		slog.Error("create window failed", err)
+		os.Exit(1)
 	}
-	glClearError()
-
-	version := gl.GoStr(gl.GetString(gl.VERSION))
-	fmt.Println("OpenGL version", version)
+	defer a.Close()
+	fmt.Println("OpenGL version", shaders.Capabilities().Version)
 
 	// Separate vertex and fragment shaders from source code.
 	vertexSource, fragSource, err := shaders.ParseCombinedBasic(strings.NewReader(shader))
@@ -82,7 +56,7 @@ func main() {
 	}
 
 	// Configure the vertex and fragment shaders
-	program, err := NewProgram(ShaderSource{Vertex: vertexSource, Fragment: fragSource})
+	program, err := shaders.NewProgram(shaders.ShaderSource{Vertex: vertexSource, Fragment: fragSource})
 	if err != nil {
 		slog.Error("compile fail", err)
 		return
@@ -96,15 +70,15 @@ func main() {
 		return
 	}
 	// Configure the Vertex Array Object.
-	vao := NewVAO()
+	vao := shaders.NewVAO()
 
 	// Create the Position Buffer Object.
-	vbo, err := NewVertexBuffer(positions)
+	vbo, err := shaders.NewVertexBuffer(positions)
 	if err != nil {
 		slog.Error("creating positions vertex buffer", err)
 		return
 	}
-	err = vao.AddAttribute(vbo, AttribLayout{
+	err = vao.AddAttribute(vbo, shaders.AttribLayout{
 		Program: program,
 		Type:    gl.FLOAT,
 		Name:    "vert\x00",
@@ -117,7 +91,7 @@ func main() {
 	}
 
 	// Create Index Buffer Object.
-	_, err = NewIndexBuffer(indices)
+	ibo, err := shaders.NewIndexBuffer(indices)
 	if err != nil {
 		slog.Error("creating index buffer", err)
 		return
@@ -129,18 +103,15 @@ func main() {
 		slog.Error("creating index buffer", err)
 		return
 	}
-	for !window.ShouldClose() {
-		gl.Clear(gl.COLOR_BUFFER_BIT)
-
-		gl.DrawElements(gl.TRIANGLES, int32(len(indices)), gl.UNSIGNED_INT, unsafe.Pointer(nil))
-
-		program.SetUniformName4f("u_color\x00", float32(time.Now().UnixMilli()%1000)/1000, .5, .3, 1)
-		// Maintenance
-		glfw.SwapInterval(1) // Can prevent epilepsy for high frequency
-		window.SwapBuffers()
-		glfw.PollEvents()
-		if window.GetKey(glfw.KeyEscape) == glfw.Press {
-			window.SetShouldClose(true)
+	err = a.Run(nil, func(f app.Frame) error {
+		a.Renderer.Clear(color.Black, 1, 0)
+		if err := a.Renderer.Draw(vao, ibo, program); err != nil {
+			return err
 		}
+		program.SetUniformName4f("u_color\x00", float32(f.Time.Milliseconds()%1000)/1000, .5, .3, 1)
+		return nil
+	})
+	if err != nil {
+		slog.Error("draw", err)
 	}
 }
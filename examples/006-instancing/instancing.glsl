@@ -0,0 +1,25 @@
+// Each instance has its own offset and color which are read
+// from a buffer with an attribute divisor of 1.
+#shader vertex
+#version 330
+
+in vec2 vert;
+in vec2 offset;
+in vec3 color;
+
+out vec3 fragColor;
+
+void main() {
+	fragColor = color;
+	gl_Position = vec4(vert + offset, 0.0, 1.0);
+}
+
+#shader fragment
+#version 330
+
+in vec3 fragColor;
+out vec4 outputColor;
+
+void main() {
+	outputColor = vec4(fragColor, 1.0);
+}
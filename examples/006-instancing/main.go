@@ -0,0 +1,182 @@
+package main
+
+import (
+	_ "embed"
+	"fmt"
+	"image/color"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/go-gl/gl/v4.6-core/gl"
+	"github.com/go-gl/glfw/v3.3/glfw"
+	"github.com/soypat/shaders"
+	"golang.org/x/exp/slog"
+)
+
+// Draws a grid of squares with a single instanced draw call.
+const (
+	projectName  = "Instancing"
+	windowWidth  = 800
+	windowHeight = 800
+	// gridSize*gridSize squares are drawn.
+	gridSize = 64
+)
+
+func init() {
+	// GLFW event handling must run on the main OS thread
+	runtime.LockOSThread()
+}
+
+//go:embed instancing.glsl
+var shader string
+
+// Small square with indices:
+// 3----2
+// |    |
+// 0----1
+const side = 1.0 / gridSize
+
+var positions = []float32{
+	0, 0, // 0
+	side, 0, // 1
+	side, side, // 2
+	0, side, //3
+}
+var indices = []uint32{
+	0, 1, 2, // Lower right triangle.
+	0, 2, 3, // Upper left triangle.
+}
+
+// instance is the per-instance data. It is read by the vertex shader
+// as the offset and color attributes.
+type instance struct {
+	offset [2]float32
+	color  [3]float32
+}
+
+func main() {
+	if err := glfw.Init(); err != nil {
+		slog.Error("failed to initialize glfw", err)
+		os.Exit(1)
+	}
+	defer glfw.Terminate()
+
+	glfw.WindowHint(glfw.Resizable, glfw.False)
+	glfw.WindowHint(glfw.ContextVersionMajor, 4)
+	glfw.WindowHint(glfw.ContextVersionMinor, 1)
+	glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
+	glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
+	window, err := glfw.CreateWindow(windowWidth, windowHeight, projectName, nil, nil)
+	if err != nil {
+		slog.Error("create glfw window failed", err)
+		return
+	}
+	window.MakeContextCurrent()
+	// Initialize Glow
+	if err := gl.Init(); err != nil {
+		slog.Error("init glow fail", err)
+		return
+	}
+	shaders.ClearErrors()
+
+	version := gl.GoStr(gl.GetString(gl.VERSION))
+	fmt.Println("OpenGL version", version)
+
+	// Separate vertex and fragment shaders from source code.
+	vertexSource, fragSource, err := shaders.ParseCombinedBasic(strings.NewReader(shader))
+	if err != nil {
+		slog.Error("parse combined source fail", err)
+		return
+	}
+	program, err := shaders.NewProgram(shaders.ShaderSource{Vertex: vertexSource, Fragment: fragSource})
+	if err != nil {
+		slog.Error("compile fail", err)
+		return
+	}
+	defer program.Delete()
+
+	vao := shaders.NewVAO()
+	vbo, err := shaders.NewVertexBuffer(positions)
+	if err != nil {
+		slog.Error("creating positions vertex buffer", err)
+		return
+	}
+	err = vao.AddAttribute(vbo, shaders.AttribLayout{
+		Program: program,
+		Type:    gl.FLOAT,
+		Name:    "vert\x00",
+		Packing: 2,
+		Stride:  2 * 4, // 2 floats, each 4 bytes wide.
+	})
+	if err != nil {
+		slog.Error("adding attribute vert", err)
+		return
+	}
+
+	// Per-instance data is interleaved in a single buffer.
+	instances := make([]instance, 0, gridSize*gridSize)
+	for i := 0; i < gridSize; i++ {
+		for j := 0; j < gridSize; j++ {
+			x, y := float32(i)/gridSize, float32(j)/gridSize
+			instances = append(instances, instance{
+				offset: [2]float32{2*x - 1, 2*y - 1},
+				color:  [3]float32{x, y, 1 - x},
+			})
+		}
+	}
+	ivbo, err := shaders.NewVertexBuffer(instances)
+	if err != nil {
+		slog.Error("creating instance vertex buffer", err)
+		return
+	}
+	const instanceSize = 5 * 4 // 5 floats, each 4 bytes wide.
+	err = vao.AddAttribute(ivbo, shaders.AttribLayout{
+		Program: program,
+		Type:    gl.FLOAT,
+		Name:    "offset\x00",
+		Packing: 2,
+		Stride:  instanceSize,
+		Divisor: 1, // Advance once per instance.
+	})
+	if err != nil {
+		slog.Error("adding attribute offset", err)
+		return
+	}
+	err = vao.AddAttribute(ivbo, shaders.AttribLayout{
+		Program: program,
+		Type:    gl.FLOAT,
+		Name:    "color\x00",
+		Packing: 3,
+		Stride:  instanceSize,
+		Offset:  2 * 4, // Color follows the 2 offset floats.
+		Divisor: 1,
+	})
+	if err != nil {
+		slog.Error("adding attribute color", err)
+		return
+	}
+
+	ibo, err := shaders.NewIndexBuffer(indices)
+	if err != nil {
+		slog.Error("creating index buffer", err)
+		return
+	}
+
+	renderer := shaders.NewRenderer()
+	for !window.ShouldClose() {
+		renderer.Clear(color.Black, 1, 0)
+		err = renderer.DrawInstanced(vao, ibo, program, len(instances))
+		if err != nil {
+			slog.Error("draw", err)
+			return
+		}
+		// Maintenance
+		glfw.SwapInterval(1)
+		window.SwapBuffers()
+		glfw.PollEvents()
+		if window.GetKey(glfw.KeyEscape) == glfw.Press {
+			window.SetShouldClose(true)
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package shaders
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// FramebufferConfig describes the attachments of a Framebuffer.
+type FramebufferConfig struct {
+	// Width and Height of all attachments in pixels.
+	Width, Height int
+	// Color contains the formats of the color attachments. Attachment i
+	// is bound to gl.COLOR_ATTACHMENT0+i and is written by the fragment
+	// shader output at location i. Multiple entries mean multiple render targets.
+	Color []TextureFormat
+	// Depth is the format of the depth, stencil or depth-stencil attachment,
+	// i.e: FormatDepth24Stencil8. The zero value means no depth attachment.
+	Depth TextureFormat
+	// DepthTexture stores the depth attachment in a texture so that
+	// it may be sampled by later passes. If false a renderbuffer is used.
+	DepthTexture bool
+}
+
+// Framebuffer is an offscreen render target. Rendering to a framebuffer
+// writes to its color textures which may then be sampled by other programs.
+type Framebuffer struct {
+	// Renderer ID. If using OpenGL is the id set on framebuffer creation.
+	rid    uint32
+	width  int32
+	height int32
+	color  []Texture
+	// Only one of depthTex or depthRB is set.
+	depthTex Texture
+	depthRB  Renderbuffer
+	// State saved by Bind and restored by Unbind.
+	prevDraw     int32
+	prevRead     int32
+	prevViewport [4]int32
+}
+
+// NewFramebuffer creates a complete framebuffer with the attachments described
+// by cfg. If the resulting framebuffer is not complete a FramebufferStatusError
+// is returned and no OpenGL objects are leaked. The framebuffers bound before
+// the call are bound on return.
+func NewFramebuffer(cfg FramebufferConfig) (_ *Framebuffer, err error) {
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, errors.New("framebuffer dimensions must be positive")
+	}
+	fb := &Framebuffer{width: int32(cfg.Width), height: int32(cfg.Height)}
+	var prevDraw, prevRead int32
+	gl.GetIntegerv(gl.DRAW_FRAMEBUFFER_BINDING, &prevDraw)
+	gl.GetIntegerv(gl.READ_FRAMEBUFFER_BINDING, &prevRead)
+	gl.GenFramebuffers(1, &fb.rid)
+	trackCreate(kindFramebuffer, fb.rid)
+	gl.BindFramebuffer(gl.FRAMEBUFFER, fb.rid)
+	defer func() {
+		gl.BindFramebuffer(gl.DRAW_FRAMEBUFFER, uint32(prevDraw))
+		gl.BindFramebuffer(gl.READ_FRAMEBUFFER, uint32(prevRead))
+		if err != nil {
+			fb.Delete()
+		}
+	}()
+	drawBuffers := make([]uint32, len(cfg.Color))
+	for i, format := range cfg.Color {
+		if format.attachment() != gl.COLOR_ATTACHMENT0 {
+			return nil, fmt.Errorf("color attachment %d has non-color format", i)
+		}
+		tex, err := NewTexture[byte](cfg.Width, cfg.Height, format, nil)
+		if err != nil {
+			return nil, fmt.Errorf("color attachment %d: %w", i, err)
+		}
+		fb.color = append(fb.color, tex)
+		drawBuffers[i] = gl.COLOR_ATTACHMENT0 + uint32(i)
+		gl.FramebufferTexture2D(gl.FRAMEBUFFER, drawBuffers[i], gl.TEXTURE_2D, tex.rid, 0)
+		if err := checkCall("glFramebufferTexture2D"); err != nil {
+			return nil, err
+		}
+	}
+	if len(drawBuffers) > 0 {
+		gl.DrawBuffers(int32(len(drawBuffers)), &drawBuffers[0])
+	} else {
+		// Depth only framebuffer, i.e: shadow maps. OpenGL ES lacks glDrawBuffer.
+		none := uint32(gl.NONE)
+		gl.DrawBuffers(1, &none)
+		gl.ReadBuffer(gl.NONE)
+	}
+
+	if cfg.Depth != (TextureFormat{}) {
+		attachment := cfg.Depth.attachment()
+		if attachment == gl.COLOR_ATTACHMENT0 {
+			return nil, errors.New("depth attachment has color format")
+		}
+		if cfg.DepthTexture {
+			fb.depthTex, err = NewTexture[byte](cfg.Width, cfg.Height, cfg.Depth, nil)
+			if err != nil {
+				return nil, fmt.Errorf("depth attachment: %w", err)
+			}
+			gl.FramebufferTexture2D(gl.FRAMEBUFFER, attachment, gl.TEXTURE_2D, fb.depthTex.rid, 0)
+		} else {
+			fb.depthRB, err = NewRenderbuffer(cfg.Width, cfg.Height, cfg.Depth.Internal)
+			if err != nil {
+				return nil, fmt.Errorf("depth attachment: %w", err)
+			}
+			gl.FramebufferRenderbuffer(gl.FRAMEBUFFER, attachment, gl.RENDERBUFFER, fb.depthRB.rid)
+		}
+	}
+	if err := glCheckError(); err != nil {
+		return nil, err
+	}
+	if err := fb.CheckStatus(); err != nil {
+		return nil, err
+	}
+	return fb, nil
+}
+
+// CheckStatus returns a FramebufferStatusError if the framebuffer is not
+// complete. The framebuffer is left bound.
+func (fb *Framebuffer) CheckStatus() error {
+	gl.BindFramebuffer(gl.FRAMEBUFFER, fb.rid)
+	status := gl.CheckFramebufferStatus(gl.FRAMEBUFFER)
+	if status != gl.FRAMEBUFFER_COMPLETE {
+		return FramebufferStatusError(status)
+	}
+	return nil
+}
+
+// Bind binds the framebuffer as the draw and read framebuffer and sets the
+// viewport to cover all of it. The previously bound draw and read framebuffers
+// and viewport are saved and restored on a call to Unbind.
+func (fb *Framebuffer) Bind() {
+	trackUse(kindFramebuffer, fb.rid)
+	gl.GetIntegerv(gl.DRAW_FRAMEBUFFER_BINDING, &fb.prevDraw)
+	gl.GetIntegerv(gl.READ_FRAMEBUFFER_BINDING, &fb.prevRead)
+	gl.GetIntegerv(gl.VIEWPORT, &fb.prevViewport[0])
+	gl.BindFramebuffer(gl.FRAMEBUFFER, fb.rid)
+	logCall("glBindFramebuffer")
+	gl.Viewport(0, 0, fb.width, fb.height)
+}
+
+// Unbind restores the draw and read framebuffers and viewport that were
+// current before the last call to Bind.
+func (fb *Framebuffer) Unbind() {
+	gl.BindFramebuffer(gl.DRAW_FRAMEBUFFER, uint32(fb.prevDraw))
+	gl.BindFramebuffer(gl.READ_FRAMEBUFFER, uint32(fb.prevRead))
+	logCall("glBindFramebuffer")
+	vp := fb.prevViewport
+	gl.Viewport(vp[0], vp[1], vp[2], vp[3])
+}
+
+// Clear clears the color attachments of the framebuffer to c, depth to 1 and
+// stencil to 0 regardless of the scissor test and write masks. Unlike
+// Renderer.Clear it leaves the pipeline state as it was, so the state Renderers
+// last applied stays valid, and the bound draw framebuffer is restored.
+func (fb *Framebuffer) Clear(c color.Color) error {
+	trackUse(kindFramebuffer, fb.rid)
+	var prevDraw, scissor, depthMask, stencilFront, stencilBack int32
+	var colorMask [4]int32
+	gl.GetIntegerv(gl.DRAW_FRAMEBUFFER_BINDING, &prevDraw)
+	gl.GetIntegerv(gl.SCISSOR_TEST, &scissor)
+	gl.GetIntegerv(gl.COLOR_WRITEMASK, &colorMask[0])
+	gl.GetIntegerv(gl.DEPTH_WRITEMASK, &depthMask)
+	gl.GetIntegerv(gl.STENCIL_WRITEMASK, &stencilFront)
+	gl.GetIntegerv(gl.STENCIL_BACK_WRITEMASK, &stencilBack)
+	gl.BindFramebuffer(gl.DRAW_FRAMEBUFFER, fb.rid)
+	gl.Disable(gl.SCISSOR_TEST)
+	gl.ColorMask(true, true, true, true)
+	gl.DepthMask(true)
+	gl.StencilMaskSeparate(gl.FRONT_AND_BACK, 0xffffffff)
+	err := clearBuffers(c, 1, 0)
+	setCapability(gl.SCISSOR_TEST, scissor != 0)
+	gl.ColorMask(colorMask[0] != 0, colorMask[1] != 0, colorMask[2] != 0, colorMask[3] != 0)
+	gl.DepthMask(depthMask != 0)
+	gl.StencilMaskSeparate(gl.FRONT, uint32(stencilFront))
+	gl.StencilMaskSeparate(gl.BACK, uint32(stencilBack))
+	gl.BindFramebuffer(gl.DRAW_FRAMEBUFFER, uint32(prevDraw))
+	return err
+}
+
+// Delete deletes the framebuffer and all of its attachments.
+func (fb *Framebuffer) Delete() {
+	for _, tex := range fb.color {
+		tex.Delete()
+	}
+	if fb.depthTex.rid != 0 {
+		fb.depthTex.Delete()
+	}
+	if fb.depthRB.rid != 0 {
+		fb.depthRB.Delete()
+	}
+	if trackDelete(kindFramebuffer, fb.rid) {
+		gl.DeleteFramebuffers(1, &fb.rid)
+	}
+	*fb = Framebuffer{}
+}
+
+// Size returns the dimensions of the framebuffer in pixels.
+func (fb *Framebuffer) Size() (width, height int) {
+	return int(fb.width), int(fb.height)
+}
+
+// NumColor returns the number of color attachments.
+func (fb *Framebuffer) NumColor() int { return len(fb.color) }
+
+// Color returns the texture of the i'th color attachment.
+func (fb *Framebuffer) Color(i int) Texture { return fb.color[i] }
+
+// DepthTexture returns the depth attachment texture. It returns the
+// zero Texture if the depth attachment is not a texture.
+func (fb *Framebuffer) DepthTexture() Texture { return fb.depthTex }
+
+// Renderbuffer is storage for a framebuffer attachment which
+// can not be sampled from, usually used for depth and stencil testing.
+type Renderbuffer struct {
+	// Renderer ID. If using OpenGL is the id set on renderbuffer creation.
+	rid uint32
+}
+
+// NewRenderbuffer creates a renderbuffer with the sized internal format
+// given, i.e: gl.DEPTH24_STENCIL8.
+func NewRenderbuffer(width, height int, internalFormat uint32) (Renderbuffer, error) {
+	var rb Renderbuffer
+	gl.GenRenderbuffers(1, &rb.rid)
+	trackCreate(kindRenderbuffer, rb.rid)
+	gl.BindRenderbuffer(gl.RENDERBUFFER, rb.rid)
+	gl.RenderbufferStorage(gl.RENDERBUFFER, internalFormat, int32(width), int32(height))
+	if err := checkErrorAfter("glRenderbufferStorage"); err != nil {
+		rb.Delete()
+		return Renderbuffer{}, err
+	}
+	return rb, nil
+}
+
+func (rb Renderbuffer) Bind() {
+	trackUse(kindRenderbuffer, rb.rid)
+	gl.BindRenderbuffer(gl.RENDERBUFFER, rb.rid)
+}
+func (rb Renderbuffer) Unbind() {
+	gl.BindRenderbuffer(gl.RENDERBUFFER, 0)
+}
+func (rb Renderbuffer) Delete() {
+	if trackDelete(kindRenderbuffer, rb.rid) {
+		gl.DeleteRenderbuffers(1, &rb.rid)
+	}
+}
+
+// FramebufferStatusError is returned when a framebuffer is not complete.
+// Its value is the result of glCheckFramebufferStatus.
+type FramebufferStatusError uint32
+
+func (fse FramebufferStatusError) Error() string {
+	var s string
+	switch fse {
+	case 0:
+		s = "status check failed"
+	case gl.FRAMEBUFFER_UNDEFINED:
+		s = "undefined"
+	case gl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT:
+		s = "incomplete attachment"
+	case gl.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT:
+		s = "missing attachment"
+	case gl.FRAMEBUFFER_INCOMPLETE_DRAW_BUFFER:
+		s = "incomplete draw buffer"
+	case gl.FRAMEBUFFER_INCOMPLETE_READ_BUFFER:
+		s = "incomplete read buffer"
+	case gl.FRAMEBUFFER_UNSUPPORTED:
+		s = "unsupported attachment format combination"
+	case gl.FRAMEBUFFER_INCOMPLETE_MULTISAMPLE:
+		s = "incomplete multisample"
+	case gl.FRAMEBUFFER_INCOMPLETE_LAYER_TARGETS:
+		s = "incomplete layer targets"
+	default:
+		return "framebuffer incomplete: unknown status " + EnumName(uint32(fse))
+	}
+	return "framebuffer incomplete: " + s
+}
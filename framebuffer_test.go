@@ -0,0 +1,103 @@
+package shaders
+
+import (
+	"errors"
+	"image/color"
+	"testing"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+func TestFramebufferCompleteness(t *testing.T) {
+	b := newFake(t)
+	before := b.Objects()
+	fb, err := NewFramebuffer(FramebufferConfig{Width: 4, Height: 2, Color: []TextureFormat{FormatRGBA8, FormatRGBA16F}, Depth: FormatDepth24Stencil8})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w, h := fb.Size(); w != 4 || h != 2 {
+		t.Errorf("got size %dx%d", w, h)
+	}
+	// Creating a framebuffer leaves the caller's bindings alone.
+	fb.Bind()
+	other, err := NewFramebuffer(FramebufferConfig{Width: 1, Height: 1, Color: []TextureFormat{FormatRGBA8}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Bound(gl.DRAW_FRAMEBUFFER) != fb.rid || b.Bound(gl.READ_FRAMEBUFFER) != fb.rid {
+		t.Errorf("got draw framebuffer %d and read framebuffer %d, want %d", b.Bound(gl.DRAW_FRAMEBUFFER), b.Bound(gl.READ_FRAMEBUFFER), fb.rid)
+	}
+	fb.Unbind()
+	if b.Bound(gl.DRAW_FRAMEBUFFER) != 0 || b.Bound(gl.READ_FRAMEBUFFER) != 0 {
+		t.Error("Unbind did not restore the default framebuffer")
+	}
+	if err := fb.CheckStatus(); err != nil {
+		t.Errorf("CheckStatus: %v", err)
+	}
+	other.Delete()
+	fb.Delete()
+
+	// A framebuffer with no attachments is incomplete.
+	empty := &Framebuffer{}
+	gl.GenFramebuffers(1, &empty.rid)
+	var status FramebufferStatusError
+	if err := empty.CheckStatus(); !errors.As(err, &status) || status != gl.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT {
+		t.Errorf("no attachments: got %v, want missing attachment", err)
+	}
+	gl.BindFramebuffer(gl.FRAMEBUFFER, 0)
+	gl.DeleteFramebuffers(1, &empty.rid)
+
+	invalid := []FramebufferConfig{
+		{Width: 0, Height: 4, Color: []TextureFormat{FormatRGBA8}},
+		// Depth formats are not color renderable.
+		{Width: 4, Height: 4, Color: []TextureFormat{FormatDepth24Stencil8}},
+		// Nor color formats depth renderable.
+		{Width: 4, Height: 4, Color: []TextureFormat{FormatRGBA8}, Depth: FormatRGBA8},
+	}
+	for _, cfg := range invalid {
+		if _, err := NewFramebuffer(cfg); err == nil {
+			t.Errorf("%+v: expected error", cfg)
+		}
+		ClearErrors()
+		if b.Bound(gl.DRAW_FRAMEBUFFER) != 0 || b.Bound(gl.READ_FRAMEBUFFER) != 0 {
+			t.Errorf("%+v: failed creation left a framebuffer bound", cfg)
+		}
+	}
+	if b.Objects() != before {
+		t.Errorf("%d objects leaked", b.Objects()-before)
+	}
+}
+
+func TestFramebufferClearKeepsState(t *testing.T) {
+	b := newFake(t)
+	fb, err := NewFramebuffer(FramebufferConfig{Width: 2, Height: 2, Color: []TextureFormat{FormatRGBA8}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fb.Delete()
+	r := NewRenderer()
+	state := DefaultPipelineState
+	state.Scissor = ScissorState{Test: true, Width: 1, Height: 1}
+	state.ColorMask = [4]bool{true, false, false, false}
+	state.Depth.Write = false
+	r.SetState(state)
+	if err := r.Clear(color.Black, 1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := fb.Clear(color.White); err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range b.Texture(fb.Color(0).rid).Texels {
+		if v != 1 {
+			t.Fatalf("texel component %d is %v, want 1: clear was masked", i, v)
+		}
+	}
+	if !b.Enabled(gl.SCISSOR_TEST) || b.State.ColorMask != state.ColorMask || b.State.DepthMask {
+		t.Errorf("pipeline state not restored: scissor %v, color mask %v, depth mask %v",
+			b.Enabled(gl.SCISSOR_TEST), b.State.ColorMask, b.State.DepthMask)
+	}
+	if b.Bound(gl.DRAW_FRAMEBUFFER) != 0 {
+		t.Errorf("draw framebuffer %d left bound", b.Bound(gl.DRAW_FRAMEBUFFER))
+	}
+	checkNoGLErrors(t)
+}
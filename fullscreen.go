@@ -0,0 +1,108 @@
+package shaders
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// fullscreenVertexBody computes the vertices of a triangle covering the viewport,
+// (-1,-1), (3,-1) and (-1,3), from gl_VertexID so no vertex attributes are needed.
+const fullscreenVertexBody = `
+void main() {
+	vec2 p = vec2((gl_VertexID << 1) & 2, gl_VertexID & 2);
+	gl_Position = vec4(2.0*p - 1.0, 0.0, 1.0);
+}
+`
+
+// fragColorOutput replaces gl_FragColor in upgraded fragment shaders.
+const fragColorOutput = "shaders_FragColor"
+
+var (
+	legacyVarying   = regexp.MustCompile(`\bvarying\b`)
+	legacyTexture   = regexp.MustCompile(`\b(texture2D|textureCube)\b`)
+	legacyFragColor = regexp.MustCompile(`\bgl_FragColor\b`)
+)
+
+// NewFragmentProgram compiles a fragment shader, such as those of The Book of
+// Shaders, into a program with a built-in vertex shader which draws a
+// triangle covering the viewport. Draw it with Renderer.DrawFullscreen.
+// The fragment shader gets no inputs from the vertex shader, it should use
+// gl_FragCoord and uniforms, see StandardUniforms.
+//
+// Legacy shaders, with no #version directive or GLSL 1.10, 1.20 or ES 1.00,
+// are upgraded to GLSL 3.30 so core profiles compile them: gl_FragColor is
+// replaced with a declared output, varying with in and texture2D and textureCube
+// with texture. Line numbers reported by the compiler are preserved.
+//
+// Shadertoy code, which defines mainImage(out vec4 fragColor, in vec2 fragCoord)
+// instead of main, is pasted unchanged: the Shadertoy inputs are declared and a
+// main function calling mainImage is generated. The program is left bound with
+// iChannel0 to iChannel3 sampling texture units 0 to 3. iChannelResolution and
+// iChannelTime are set by the caller, i.e: SetUniformName3f("iChannelResolution[0]\x00", w, h, 1).
+func NewFragmentProgram(fragment string) (Program, error) {
+	if !strings.HasSuffix(fragment, "\x00") {
+		return Program{}, errors.New("fragment shader source has no null terminator")
+	}
+	shadertoy := isShadertoy(fragment)
+	if shadertoy {
+		fragment = wrapShadertoy(fragment)
+	} else {
+		fragment = upgradeFragment(fragment)
+	}
+	directive := "#version 330"
+	if lineStart, lineEnd, _, _ := findVersion(fragment); lineEnd != 0 {
+		// OpenGL ES requires the stages of a program to have the same version.
+		directive = fragment[lineStart:lineEnd]
+	}
+	prog, err := NewProgram(ShaderSource{Vertex: directive + fullscreenVertexBody + "\x00", Fragment: fragment})
+	if err != nil || !shadertoy {
+		return prog, err
+	}
+	if err := setShadertoyChannels(prog); err != nil {
+		prog.Delete()
+		return Program{}, err
+	}
+	return prog, nil
+}
+
+// upgradeFragment rewrites a legacy fragment shader to GLSL 3.30. Shaders
+// with a newer #version directive are returned unchanged, as are all shaders
+// if the context does not support GLSL 1.30 or GLSL ES 3.00.
+func upgradeFragment(src string) string {
+	ctx := contextVersion()
+	if glsl := glslVersion(ctx); ctx.es && glsl < 300 || !ctx.es && glsl < 130 {
+		return src
+	}
+	lineStart, lineEnd, num, profile := findVersion(src)
+	if lineEnd != 0 && (num >= 130 || profile == "es" && num >= 300) {
+		return src
+	}
+	if lineEnd != 0 {
+		// Blank out the directive so the following lines keep their numbers.
+		src = src[:lineStart] + src[lineEnd:]
+	}
+	src = legacyVarying.ReplaceAllString(src, "in")
+	src = legacyTexture.ReplaceAllString(src, "texture")
+	src = legacyFragColor.ReplaceAllString(src, fragColorOutput)
+	// GLSL 3.30 #line sets the number of the line following the directive.
+	return "#version 330\nout vec4 " + fragColorOutput + ";\n#line 1\n" + src
+}
+
+// DrawFullscreen draws a triangle covering the viewport with a program created by
+// NewFragmentProgram, regardless of the primitive set with SetPrimitive.
+// The empty vertex array core profiles require to draw is created on first
+// use and lives as long as the OpenGL context.
+func (r *Renderer) DrawFullscreen(prog Program) error {
+	if r.fullscreenVAO.rid == 0 {
+		// Not tracked since it is never deleted and would be reported as leaked.
+		gl.GenVertexArrays(1, &r.fullscreenVAO.rid)
+	}
+	primitive := r.primitive
+	r.primitive = gl.TRIANGLES
+	err := r.DrawArrays(r.fullscreenVAO, prog, 0, 3)
+	r.primitive = primitive
+	return err
+}
@@ -0,0 +1,66 @@
+package shaders
+
+import "testing"
+
+func TestUpgradeFragment(t *testing.T) {
+	const header = "#version 330\nout vec4 shaders_FragColor;\n#line 1\n"
+	tests := []struct {
+		name         string
+		major, minor int
+		es           bool
+		src, want    string
+	}{
+		{
+			name: "no directive", major: 4, minor: 6,
+			src:  "varying vec2 uv;\nuniform sampler2D t;\nvoid main() { gl_FragColor = texture2D(t, uv); }\n",
+			want: header + "in vec2 uv;\nuniform sampler2D t;\nvoid main() { shaders_FragColor = texture(t, uv); }\n",
+		},
+		{
+			// The blank line keeps the numbers of the following lines.
+			name: "legacy directive", major: 3, minor: 3,
+			src:  "#version 120\nvoid main() { gl_FragColor = vec4(1.0); }\n",
+			want: header + "\nvoid main() { shaders_FragColor = vec4(1.0); }\n",
+		},
+		{
+			name: "directive after comment", major: 3, minor: 3,
+			src:  "// Legacy.\n#version 110\nvoid main() {}\n",
+			want: header + "// Legacy.\n\nvoid main() {}\n",
+		},
+		{
+			name: "GLSL ES 1.00", major: 3, minor: 2, es: true,
+			src:  "#version 100\nprecision mediump float;\nvoid main() { gl_FragColor = textureCube(c, v); }\n",
+			want: header + "\nprecision mediump float;\nvoid main() { shaders_FragColor = texture(c, v); }\n",
+		},
+		{
+			name: "whole words only", major: 4, minor: 1,
+			src:  "varying_t x;\nfloat myvarying, texture2DLod, gl_FragColorX;\nvoid main() { gl_FragColor.rgb = vec3(texture2DLod); }\n",
+			want: header + "varying_t x;\nfloat myvarying, texture2DLod, gl_FragColorX;\nvoid main() { shaders_FragColor.rgb = vec3(texture2DLod); }\n",
+		},
+		{
+			name: "modern directive", major: 4, minor: 6,
+			src:  "#version 330 core\nout vec4 c;\nvarying float v;\n",
+			want: "#version 330 core\nout vec4 c;\nvarying float v;\n",
+		},
+		{
+			name: "modern ES directive", major: 3, es: true,
+			src:  "#version 300 es\nout vec4 c;\n",
+			want: "#version 300 es\nout vec4 c;\n",
+		},
+		{
+			name: "GLSL 1.20 context", major: 2, minor: 1,
+			src:  "void main() { gl_FragColor = vec4(1.0); }\n",
+			want: "void main() { gl_FragColor = vec4(1.0); }\n",
+		},
+		{
+			name: "GLSL ES 1.00 context", major: 2, es: true,
+			src:  "#version 100\nvoid main() { gl_FragColor = vec4(1.0); }\n",
+			want: "#version 100\nvoid main() { gl_FragColor = vec4(1.0); }\n",
+		},
+	}
+	for _, test := range tests {
+		newFakeVersion(t, test.major, test.minor, test.es)
+		if got := upgradeFragment(test.src); got != test.want {
+			t.Errorf("%s: got\n%q\nwant\n%q", test.name, got, test.want)
+		}
+	}
+}
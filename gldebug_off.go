@@ -0,0 +1,6 @@
+//go:build !gldebug
+
+package shaders
+
+// debugGL is false in release builds so that checkCall compiles away.
+const debugGL = false
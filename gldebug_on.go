@@ -0,0 +1,7 @@
+//go:build gldebug
+
+package shaders
+
+// debugGL enables checking for OpenGL errors after every call the package makes.
+// Build with the gldebug tag to enable it, i.e: go run -tags gldebug .
+const debugGL = true
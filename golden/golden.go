@@ -0,0 +1,332 @@
+// Package golden regression tests shaders against stored golden images. Shaders
+// are rendered offscreen with a headless OpenGL context, so tests run on machines
+// with no display or GPU using Mesa's llvmpipe, and compared with PNG files.
+//
+//	var harness *golden.Harness
+//
+//	func TestMain(m *testing.M) {
+//		var err error
+//		harness, err = golden.NewHarness()
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		code := m.Run()
+//		harness.Close()
+//		os.Exit(code)
+//	}
+//
+//	func TestPlasma(t *testing.T) {
+//		harness.Check(t, golden.Case{Source: plasma, Width: 64, Height: 64, Time: 1.5, Golden: "testdata/plasma.png"})
+//	}
+//
+// Run the tests with the -update flag to write the golden images after
+// reviewing the rendered output, i.e: go test -run Plasma -update.
+//
+// Importing the package defines -update in the test binary. Test packages using
+// the harness must not define a flag of their own with that name, the flag
+// package panics on redefinition. They may read its value with flag.Lookup("update").
+package golden
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/soypat/shaders"
+	"github.com/soypat/shaders/headless"
+	"github.com/soypat/shaders/imagediff"
+	"github.com/soypat/shaders/internal/bindings"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+var update = flag.Bool("update", false, "write rendered images to golden files instead of comparing")
+
+// Case is a golden image test of a shader.
+type Case struct {
+	// Source is a combined vertex and fragment shader, see shaders.ParseCombinedBasic.
+	// The vertex shader is fed a quad covering the whole target with two triangles
+	// through the vec2 attribute named by Attribute. If Source has only a fragment
+	// stage it is drawn over the whole target, see shaders.NewFragmentProgram.
+	Source string
+	// Attribute is the name of the vertex position attribute. Defaults to "vert".
+	// If the program has no such attribute six vertices are drawn with no attributes.
+	Attribute string
+	// Width and Height of the rendered image in pixels.
+	Width, Height int
+	// Time in seconds and the image size are set to the standard uniforms
+	// of shaders.StandardUniforms, i.e: u_time, iTime, u_resolution and iResolution.
+	// Uniforms the program lacks are ignored.
+	Time float32
+	// Uniforms are set before drawing. Values have 1 to 4 components
+	// which are set with glUniform1f to glUniform4f respectively.
+	Uniforms map[string][]float32
+	// Golden is the path to the golden PNG image, i.e: "testdata/plasma.png".
+	Golden string
+	// Tolerance is the largest difference of a channel, in the 0-255 range,
+	// for which a pixel is considered equal to its golden counterpart.
+	Tolerance uint8
+	// MaxDiffPixels is the number of differing pixels the test tolerates.
+	MaxDiffPixels int
+}
+
+// Harness renders shaders with a headless OpenGL context. OpenGL contexts are
+// bound to OS threads so all OpenGL calls are made from a goroutine owned by
+// the harness and a Harness may be used by any goroutine.
+type Harness struct {
+	calls chan func()
+	done  chan struct{}
+}
+
+// NewHarness creates a headless OpenGL context and sets the package shaders
+// backend to the go-gl bindings initialized for it, unless one was selected
+// with the gl33 or gl41 build tag.
+func NewHarness() (*Harness, error) {
+	h := &Harness{calls: make(chan func()), done: make(chan struct{})}
+	errc := make(chan error)
+	go h.loop(errc)
+	if err := <-errc; err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func (h *Harness) loop(errc chan<- error) {
+	runtime.LockOSThread()
+	defer close(h.done)
+	ctx, err := headless.New(headless.Config{Major: 3, Minor: 3})
+	if err == nil {
+		err = bindings.Init(false, ctx.ProcAddress)
+		if err != nil {
+			ctx.Destroy()
+		}
+	}
+	errc <- err
+	if err != nil {
+		return
+	}
+	defer ctx.Destroy()
+	shaders.ClearErrors()
+	for call := range h.calls {
+		call()
+	}
+}
+
+// do runs f on the harness OpenGL thread.
+func (h *Harness) do(f func()) {
+	finished := make(chan struct{})
+	h.calls <- func() {
+		defer close(finished)
+		f()
+	}
+	<-finished
+}
+
+// Close destroys the OpenGL context.
+func (h *Harness) Close() {
+	close(h.calls)
+	<-h.done
+}
+
+// Render renders the shader of c and returns the image with its origin at the top left.
+func (h *Harness) Render(c Case) (img *image.RGBA, err error) {
+	h.do(func() { img, err = render(c) })
+	return img, err
+}
+
+// Check renders c and compares it with its golden image. On failure it writes
+// the rendered image and a diff image next to the golden image, with the extensions
+// ".actual.png" and ".diff.png", and reports an error. With the -update
+// flag the rendered image is written to the golden file instead.
+func (h *Harness) Check(t testing.TB, c Case) {
+	t.Helper()
+	got, err := h.Render(c)
+	if err != nil {
+		t.Fatalf("render %s: %v", c.Golden, err)
+	}
+	if *update {
+		if err := writePNG(c.Golden, got); err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("updated golden image %s", c.Golden)
+		return
+	}
+	want, err := readPNG(c.Golden)
+	if err != nil {
+		t.Fatalf("%v (run with -update to create it)", err)
+	}
+	differing, diff, err := Compare(want, got, c.Tolerance)
+	if err != nil {
+		t.Fatalf("%s: %v", c.Golden, err)
+	}
+	if differing <= c.MaxDiffPixels {
+		return
+	}
+	base := strings.TrimSuffix(c.Golden, ".png")
+	if err := writePNG(base+".actual.png", got); err != nil {
+		t.Error(err)
+	}
+	if err := writePNG(base+".diff.png", diff); err != nil {
+		t.Error(err)
+	}
+	psnr, _ := imagediff.PSNR(want, got)
+	flip, _ := imagediff.FLIP(want, got, 0)
+	t.Errorf("%s: %d pixels differ by more than %d, %d allowed (PSNR %.1f dB, mean FLIP %.4f). See %s.diff.png",
+		c.Golden, differing, c.Tolerance, c.MaxDiffPixels, psnr, flip.Mean(), base)
+}
+
+// Compare counts the pixels of got with a channel differing by more than
+// tolerance from want. The returned diff image shows differing pixels in
+// red over a faded grayscale copy of want. Images must have the same size.
+func Compare(want, got image.Image, tolerance uint8) (differing int, diff *image.RGBA, err error) {
+	bounds := want.Bounds()
+	if bounds.Size() != got.Bounds().Size() {
+		return 0, nil, fmt.Errorf("image size %v does not match golden size %v", got.Bounds().Size(), bounds.Size())
+	}
+	offset := got.Bounds().Min.Sub(bounds.Min)
+	diff = image.NewRGBA(image.Rectangle{Max: bounds.Size()})
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			w := color.NRGBAModel.Convert(want.At(x, y)).(color.NRGBA)
+			g := color.NRGBAModel.Convert(got.At(x+offset.X, y+offset.Y)).(color.NRGBA)
+			dx, dy := x-bounds.Min.X, y-bounds.Min.Y
+			if channelDiff(w.R, g.R) > tolerance || channelDiff(w.G, g.G) > tolerance ||
+				channelDiff(w.B, g.B) > tolerance || channelDiff(w.A, g.A) > tolerance {
+				differing++
+				diff.SetRGBA(dx, dy, color.RGBA{R: 255, A: 255})
+				continue
+			}
+			gray := uint8((uint16(w.R) + uint16(w.G) + uint16(w.B)) / 3 / 4)
+			diff.SetRGBA(dx, dy, color.RGBA{R: gray, G: gray, B: gray, A: 255})
+		}
+	}
+	return differing, diff, nil
+}
+
+func channelDiff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// quad covers clip space with two triangles.
+var quad = []float32{
+	-1, -1, 1, -1, 1, 1,
+	-1, -1, 1, 1, -1, 1,
+}
+
+func render(c Case) (*image.RGBA, error) {
+	if c.Width <= 0 || c.Height <= 0 {
+		return nil, errors.New("golden: image dimensions must be positive")
+	}
+	vertex, fragment, err := shaders.ParseCombinedBasic(strings.NewReader(c.Source))
+	if err != nil {
+		return nil, err
+	}
+	prog, err := shaders.NewProgram(shaders.ShaderSource{Vertex: vertex, Fragment: fragment})
+	if err != nil {
+		return nil, err
+	}
+	defer prog.Delete()
+	fb, err := shaders.NewFramebuffer(shaders.FramebufferConfig{
+		Width:  c.Width,
+		Height: c.Height,
+		Color:  []shaders.TextureFormat{shaders.FormatRGBA8},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer fb.Delete()
+	vbo, err := shaders.NewVertexBuffer(quad)
+	if err != nil {
+		return nil, err
+	}
+	defer vbo.Delete()
+	vao := shaders.NewVAO()
+	defer vao.Delete()
+	attribute := c.Attribute
+	if attribute == "" {
+		attribute = "vert"
+	}
+	err = vao.AddAttribute(vbo, shaders.AttribLayout{
+		Program: prog,
+		Type:    gl.FLOAT,
+		Name:    attribute + "\x00",
+		Packing: 2,
+		Stride:  2 * 4,
+	})
+	// Programs that compute positions from gl_VertexID have no position attribute.
+	if err != nil && !errors.Is(err, shaders.ErrNoAttribute) {
+		return nil, err
+	}
+
+	prog.Bind()
+	if err := setUniforms(prog, c); err != nil {
+		return nil, err
+	}
+	renderer := shaders.NewRenderer()
+	fb.Bind()
+	defer fb.Unbind()
+	if err := renderer.Clear(color.Transparent, 1, 0); err != nil {
+		return nil, err
+	}
+	if err := renderer.DrawArrays(vao, prog, 0, len(quad)/2); err != nil {
+		return nil, err
+	}
+	return fb.ReadRGBA(0)
+}
+
+func setUniforms(prog shaders.Program, c Case) error {
+	standard := shaders.StandardUniforms{Time: c.Time, Width: float32(c.Width), Height: float32(c.Height)}
+	if err := standard.Apply(prog); err != nil {
+		return err
+	}
+	for name, v := range c.Uniforms {
+		cname := name + "\x00"
+		var err error
+		switch len(v) {
+		case 1:
+			err = prog.SetUniformName1f(cname, v[0])
+		case 2:
+			err = prog.SetUniformName2f(cname, v[0], v[1])
+		case 3:
+			err = prog.SetUniformName3f(cname, v[0], v[1], v[2])
+		case 4:
+			err = prog.SetUniformName4f(cname, v[0], v[1], v[2], v[3])
+		default:
+			err = errors.New("must have 1 to 4 components")
+		}
+		if err != nil {
+			return fmt.Errorf("uniform %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func readPNG(name string) (image.Image, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return png.Decode(f)
+}
+
+func writePNG(name string, img image.Image) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,166 @@
+package golden
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	gray := func(width, height int, v uint8) *image.NRGBA {
+		img := image.NewNRGBA(image.Rect(0, 0, width, height))
+		for i := range img.Pix {
+			img.Pix[i] = v
+		}
+		return img
+	}
+	spot := gray(4, 4, 100)
+	spot.SetNRGBA(1, 2, color.NRGBA{R: 110, G: 100, B: 100, A: 100})
+	tests := []struct {
+		name      string
+		want, got image.Image
+		tolerance uint8
+		differing int
+		wantErr   bool
+	}{
+		{name: "identical", want: gray(4, 4, 100), got: gray(4, 4, 100)},
+		{name: "all differ", want: gray(4, 4, 100), got: gray(4, 4, 102), differing: 16},
+		{name: "within tolerance", want: gray(4, 4, 100), got: gray(4, 4, 102), tolerance: 2},
+		{name: "one pixel", want: gray(4, 4, 100), got: spot, tolerance: 9, differing: 1},
+		{name: "one pixel tolerated", want: gray(4, 4, 100), got: spot, tolerance: 10},
+		{name: "offset bounds", want: spot, got: gray(8, 8, 100).SubImage(image.Rect(3, 3, 7, 7)), differing: 1},
+		{name: "size mismatch", want: gray(4, 4, 100), got: gray(4, 3, 100), wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			differing, diff, err := Compare(test.want, test.got, test.tolerance)
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if differing != test.differing {
+				t.Errorf("got %d differing pixels, want %d", differing, test.differing)
+			}
+			if diff.Bounds() != image.Rect(0, 0, 4, 4) {
+				t.Fatalf("diff bounds %v", diff.Bounds())
+			}
+			var red int
+			for i := 0; i < len(diff.Pix); i += 4 {
+				if diff.Pix[i] == 255 && diff.Pix[i+1] == 0 {
+					red++
+				}
+			}
+			if red != differing {
+				t.Errorf("diff image marks %d pixels, want %d", red, differing)
+			}
+		})
+	}
+}
+
+// recorder records errors reported by Harness.Check instead of failing the test.
+type recorder struct {
+	testing.TB
+	errs []string
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errs = append(r.errs, fmt.Sprintf(format, args...))
+}
+
+const solid = `#shader fragment
+#version 330
+out vec4 color;
+uniform vec4 u_color;
+uniform vec2 u_resolution;
+void main() {
+	color = u_color;
+	if (gl_FragCoord.y > u_resolution.y/2.0) {
+		color.b = 1.0;
+	}
+}
+`
+
+const quadShader = `#shader vertex
+#version 330
+in vec2 pos;
+void main() { gl_Position = vec4(pos, 0.0, 1.0); }
+#shader fragment
+#version 330
+out vec4 color;
+void main() { color = vec4(0.0, 1.0, 0.0, 1.0); }
+`
+
+func TestHarness(t *testing.T) {
+	h, err := NewHarness()
+	if err != nil {
+		t.Skip("no headless OpenGL context:", err)
+	}
+	defer h.Close()
+
+	c := Case{Source: solid, Width: 4, Height: 4, Uniforms: map[string][]float32{"u_color": {1, 0, 0, 1}}}
+	img, err := h.Render(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The image origin is at the top left, the upper half is blue.
+	if got := img.RGBAAt(0, 0); got != (color.RGBA{255, 0, 255, 255}) {
+		t.Errorf("top: got %v", got)
+	}
+	if got := img.RGBAAt(3, 3); got != (color.RGBA{255, 0, 0, 255}) {
+		t.Errorf("bottom: got %v", got)
+	}
+
+	img, err = h.Render(Case{Source: quadShader, Attribute: "pos", Width: 2, Height: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := img.RGBAAt(1, 1); got != (color.RGBA{0, 255, 0, 255}) {
+		t.Errorf("quad: got %v", got)
+	}
+	for _, bad := range []Case{
+		{Source: solid, Width: 0, Height: 4},
+		{Source: solid, Width: 4, Height: 4, Uniforms: map[string][]float32{"u_color": {1, 2, 3, 4, 5}}},
+		{Source: "#shader fragment\n#version 330\nvoid main() { undefined(); }\n", Width: 4, Height: 4},
+	} {
+		if _, err := h.Render(bad); err == nil {
+			t.Errorf("expected error rendering %+v", bad)
+		}
+	}
+
+	dir := t.TempDir()
+	c.Golden = filepath.Join(dir, "solid.png")
+	*update = true
+	h.Check(t, c)
+	*update = false
+	if _, err := os.Stat(c.Golden); err != nil {
+		t.Fatal(err)
+	}
+	h.Check(t, c)
+
+	// A different color differs in every pixel.
+	c.Uniforms = map[string][]float32{"u_color": {0, 0, 0, 1}}
+	rec := &recorder{TB: t}
+	h.Check(rec, c)
+	if len(rec.errs) != 1 {
+		t.Fatalf("got errors %q, want one", rec.errs)
+	}
+	for _, ext := range []string{".actual.png", ".diff.png"} {
+		if _, err := os.Stat(filepath.Join(dir, "solid"+ext)); err != nil {
+			t.Error(err)
+		}
+	}
+	c.MaxDiffPixels = 16
+	rec.errs = nil
+	h.Check(rec, c)
+	if len(rec.errs) != 0 {
+		t.Errorf("got errors %q with all pixels allowed to differ", rec.errs)
+	}
+}
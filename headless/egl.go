@@ -0,0 +1,125 @@
+//go:build !osmesa
+
+package headless
+
+/*
+#cgo pkg-config: egl
+#include <stdlib.h>
+#include <EGL/egl.h>
+#include <EGL/eglext.h>
+
+#ifndef EGL_PLATFORM_SURFACELESS_MESA
+#define EGL_PLATFORM_SURFACELESS_MESA 0x31DD
+#endif
+
+// surfacelessDisplay returns the Mesa surfaceless platform display which needs
+// no window system. It falls back to the default display if the platform is missing.
+static EGLDisplay surfacelessDisplay() {
+	PFNEGLGETPLATFORMDISPLAYEXTPROC getPlatformDisplay =
+		(PFNEGLGETPLATFORMDISPLAYEXTPROC)eglGetProcAddress("eglGetPlatformDisplayEXT");
+	if (getPlatformDisplay != NULL) {
+		EGLDisplay d = getPlatformDisplay(EGL_PLATFORM_SURFACELESS_MESA, EGL_DEFAULT_DISPLAY, NULL);
+		if (d != EGL_NO_DISPLAY) {
+			return d;
+		}
+	}
+	return eglGetDisplay(EGL_DEFAULT_DISPLAY);
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Context is a headless OpenGL context.
+type Context struct {
+	display C.EGLDisplay
+	context C.EGLContext
+}
+
+func newContext(cfg Config) (*Context, error) {
+	display := C.surfacelessDisplay()
+	if display == C.EGLDisplay(C.EGL_NO_DISPLAY) {
+		return nil, eglError("eglGetDisplay")
+	}
+	var major, minor C.EGLint
+	if C.eglInitialize(display, &major, &minor) == C.EGL_FALSE {
+		return nil, eglError("eglInitialize")
+	}
+	c := &Context{display: display, context: C.EGLContext(C.EGL_NO_CONTEXT)}
+	api, renderable := C.EGLenum(C.EGL_OPENGL_API), C.EGLint(C.EGL_OPENGL_BIT)
+	if cfg.ES {
+		api, renderable = C.EGL_OPENGL_ES_API, C.EGL_OPENGL_ES3_BIT
+	}
+	if C.eglBindAPI(api) == C.EGL_FALSE {
+		c.Destroy()
+		return nil, eglError("eglBindAPI")
+	}
+	// No surface is ever created so any surface type will do, the default is windows only.
+	configAttribs := []C.EGLint{C.EGL_RENDERABLE_TYPE, renderable, C.EGL_SURFACE_TYPE, 0, C.EGL_NONE}
+	var config C.EGLConfig
+	var n C.EGLint
+	if C.eglChooseConfig(display, &configAttribs[0], &config, 1, &n) == C.EGL_FALSE || n == 0 {
+		c.Destroy()
+		return nil, eglError("eglChooseConfig")
+	}
+	glMajor, glMinor := cfg.version()
+	attribs := []C.EGLint{
+		C.EGL_CONTEXT_MAJOR_VERSION, C.EGLint(glMajor),
+		C.EGL_CONTEXT_MINOR_VERSION, C.EGLint(glMinor),
+	}
+	if !cfg.ES {
+		profile := C.EGLint(C.EGL_CONTEXT_OPENGL_CORE_PROFILE_BIT)
+		if cfg.Compatibility {
+			profile = C.EGL_CONTEXT_OPENGL_COMPATIBILITY_PROFILE_BIT
+		}
+		attribs = append(attribs, C.EGL_CONTEXT_OPENGL_PROFILE_MASK, profile)
+	}
+	if cfg.Debug {
+		attribs = append(attribs, C.EGL_CONTEXT_OPENGL_DEBUG, C.EGL_TRUE)
+	}
+	attribs = append(attribs, C.EGL_NONE)
+	c.context = C.eglCreateContext(display, config, C.EGLContext(C.EGL_NO_CONTEXT), &attribs[0])
+	if c.context == C.EGLContext(C.EGL_NO_CONTEXT) {
+		c.Destroy()
+		return nil, eglError("eglCreateContext")
+	}
+	if err := c.MakeCurrent(); err != nil {
+		c.Destroy()
+		return nil, err
+	}
+	return c, nil
+}
+
+// MakeCurrent makes the context current on the calling thread. When switching
+// between contexts call shaders.InvalidateContext afterwards.
+func (c *Context) MakeCurrent() error {
+	noSurface := C.EGLSurface(C.EGL_NO_SURFACE)
+	if C.eglMakeCurrent(c.display, noSurface, noSurface, c.context) == C.EGL_FALSE {
+		return eglError("eglMakeCurrent")
+	}
+	return nil
+}
+
+// Destroy releases the context. It must not be used afterwards.
+func (c *Context) Destroy() {
+	noSurface := C.EGLSurface(C.EGL_NO_SURFACE)
+	C.eglMakeCurrent(c.display, noSurface, noSurface, C.EGLContext(C.EGL_NO_CONTEXT))
+	if c.context != C.EGLContext(C.EGL_NO_CONTEXT) {
+		C.eglDestroyContext(c.display, c.context)
+	}
+	// The display is shared by all contexts so it is not terminated.
+	*c = Context{}
+}
+
+func (c *Context) procAddress(name string) unsafe.Pointer {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return unsafe.Pointer(C.eglGetProcAddress(cname))
+}
+
+func eglError(fn string) error {
+	return fmt.Errorf("headless: %s failed with EGL error 0x%x", fn, int(C.eglGetError()))
+}
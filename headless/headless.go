@@ -0,0 +1,54 @@
+// Package headless creates OpenGL contexts with no window or display so that
+// shaders can be compiled and rendered on servers and CI containers, including
+// ones with no GPU where Mesa's llvmpipe software rasterizer is used.
+//
+// By default contexts are created with EGL on a surfaceless display. Build with
+// the osmesa tag to use Mesa's off-screen OSMesa library instead.
+// Contexts have no usable default framebuffer, render to a shaders.Framebuffer.
+//
+//	runtime.LockOSThread()
+//	ctx, err := headless.New(headless.Config{Major: 4, Minor: 5})
+//	if err != nil {
+//		return err
+//	}
+//	defer ctx.Destroy()
+//	err = gl.InitWithProcAddrFunc(ctx.ProcAddress)
+package headless
+
+import "unsafe"
+
+// Config describes the context requested.
+type Config struct {
+	// Major and Minor is the OpenGL version requested. Drivers may return a
+	// newer compatible version. The zero value requests OpenGL 3.3 or OpenGL ES 3.0.
+	Major, Minor int
+	// ES requests an OpenGL ES context. Not supported by OSMesa.
+	ES bool
+	// Compatibility requests a compatibility profile instead of a core profile context.
+	Compatibility bool
+	// Debug requests a debug context, see shaders.EnableDebugOutput.
+	Debug bool
+}
+
+func (cfg Config) version() (major, minor int) {
+	if cfg.Major == 0 {
+		if cfg.ES {
+			return 3, 0
+		}
+		return 3, 3
+	}
+	return cfg.Major, cfg.Minor
+}
+
+// New creates a context and makes it current on the calling thread. OpenGL
+// contexts are bound to OS threads so the caller should call runtime.LockOSThread first.
+func New(cfg Config) (*Context, error) {
+	return newContext(cfg)
+}
+
+// ProcAddress returns the address of the OpenGL function name or nil if it is not
+// available. It is meant to be passed to the InitWithProcAddrFunc function of go-gl
+// bindings, which then need no build tags to find the functions of the context.
+func (c *Context) ProcAddress(name string) unsafe.Pointer {
+	return c.procAddress(name)
+}
@@ -0,0 +1,82 @@
+//go:build osmesa
+
+package headless
+
+/*
+#cgo pkg-config: osmesa
+#include <stdlib.h>
+#include <GL/osmesa.h>
+
+static void *procAddress(const char *name) {
+	return (void *)OSMesaGetProcAddress(name);
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+)
+
+// Context is a headless OpenGL context.
+type Context struct {
+	context C.OSMesaContext
+	// buffer is the 1x1 color buffer OSMesa requires to make the context current.
+	buffer unsafe.Pointer
+}
+
+func newContext(cfg Config) (*Context, error) {
+	if cfg.ES {
+		return nil, errors.New("headless: OSMesa does not support OpenGL ES contexts")
+	}
+	major, minor := cfg.version()
+	profile := C.int(C.OSMESA_CORE_PROFILE)
+	if cfg.Compatibility {
+		profile = C.OSMESA_COMPAT_PROFILE
+	}
+	// OSMesa has no debug contexts, KHR_debug output works regardless.
+	attribs := []C.int{
+		C.OSMESA_FORMAT, C.OSMESA_RGBA,
+		C.OSMESA_DEPTH_BITS, 24,
+		C.OSMESA_STENCIL_BITS, 8,
+		C.OSMESA_PROFILE, profile,
+		C.OSMESA_CONTEXT_MAJOR_VERSION, C.int(major),
+		C.OSMESA_CONTEXT_MINOR_VERSION, C.int(minor),
+		0,
+	}
+	c := &Context{context: C.OSMesaCreateContextAttribs(&attribs[0], nil)}
+	if c.context == nil {
+		return nil, fmt.Errorf("headless: OSMesaCreateContextAttribs failed for OpenGL %d.%d", major, minor)
+	}
+	c.buffer = C.malloc(4)
+	if err := c.MakeCurrent(); err != nil {
+		c.Destroy()
+		return nil, err
+	}
+	return c, nil
+}
+
+// MakeCurrent makes the context current on the calling thread. When switching
+// between contexts call shaders.InvalidateContext afterwards.
+func (c *Context) MakeCurrent() error {
+	if C.OSMesaMakeCurrent(c.context, c.buffer, C.GL_UNSIGNED_BYTE, 1, 1) == C.GL_FALSE {
+		return errors.New("headless: OSMesaMakeCurrent failed")
+	}
+	return nil
+}
+
+// Destroy releases the context. It must not be used afterwards.
+func (c *Context) Destroy() {
+	if c.context != nil {
+		C.OSMesaDestroyContext(c.context)
+	}
+	C.free(c.buffer)
+	*c = Context{}
+}
+
+func (c *Context) procAddress(name string) unsafe.Pointer {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return C.procAddress(cname)
+}
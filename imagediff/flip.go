@@ -0,0 +1,253 @@
+package imagediff
+
+import (
+	"image"
+	"math"
+)
+
+// DefaultPPD is the number of pixels per degree of visual angle FLIP assumes
+// by default: a 0.7 m wide 4K monitor viewed from 0.7 m.
+const DefaultPPD = 67
+
+// Constants of the FLIP paper, Andersson et al. 2020.
+const (
+	flipQc = 0.7   // Color difference exponent.
+	flipPc = 0.4   // Color difference compression threshold.
+	flipPt = 0.95  // Color difference compression output.
+	flipQf = 0.5   // Feature difference exponent.
+	flipW  = 0.082 // Feature detector width in degrees.
+)
+
+// contrastSensitivity holds the parameters of the spatial contrast sensitivity
+// functions of the achromatic, red-green and blue-yellow channels, each the
+// sum of two Gaussians a*sqrt(pi/b)*exp(-pi²x²/b).
+var contrastSensitivity = [3][2]struct{ a, b float64 }{
+	{{1, 0.0047}, {0, 1e-5}},
+	{{1, 0.0053}, {0, 1e-5}},
+	{{34.1, 0.04}, {13.5, 0.025}},
+}
+
+// FLIP returns the perceptual difference of every pixel of test with respect to
+// reference, approximating NVIDIA's LDR FLIP. Images are filtered with models of
+// the contrast sensitivity of the human eye, their colors compared in a perceptually
+// uniform space and the result amplified where edges and points differ.
+// Errors are in [0, 1] where 0 means no visible difference. ppd is the number of
+// pixels per degree of visual angle of the viewing setup, DefaultPPD if not positive.
+func FLIP(reference, test image.Image, ppd float64) (*ErrorMap, error) {
+	if ppd <= 0 {
+		ppd = DefaultPPD
+	}
+	pr, pt, width, height, err := rgbPair(reference, test)
+	if err != nil {
+		return nil, err
+	}
+	ref, tst := toYCxCz(pr), toYCxCz(pt)
+	color := colorDifference(ref, tst, width, height, ppd)
+	feature := featureDifference(ref, tst, width, height, ppd)
+	for i := range color {
+		color[i] = math.Pow(color[i], 1-feature[i])
+	}
+	return &ErrorMap{Width: width, Height: height, Values: color}, nil
+}
+
+// colorDifference returns the spatially filtered color difference of every pixel.
+func colorDifference(ref, tst []float64, width, height int, ppd float64) []float64 {
+	fr := toHuntLab(csfFilter(ref, width, height, ppd))
+	ft := toHuntLab(csfFilter(tst, width, height, ppd))
+	cmax := math.Pow(maxHyAB(), flipQc)
+	diff := make([]float64, width*height)
+	for i := range diff {
+		e := math.Pow(hyAB(fr[3*i:3*i+3], ft[3*i:3*i+3]), flipQc)
+		// Compress large differences to leave room for feature amplification.
+		if e < flipPc*cmax {
+			e *= flipPt / (flipPc * cmax)
+		} else {
+			e = flipPt + (e-flipPc*cmax)/(cmax-flipPc*cmax)*(1-flipPt)
+		}
+		diff[i] = clamp01(e)
+	}
+	return diff
+}
+
+// csfFilter filters the YCxCz channels with their contrast sensitivity function.
+// The result is converted to linear RGB, clamped, and back to YCxCz.
+func csfFilter(ycc []float64, width, height int, ppd float64) []float64 {
+	var filtered [3][]float64
+	for c := range filtered {
+		src := channel(ycc, c)
+		var total float64
+		for _, g := range contrastSensitivity[c] {
+			if g.a == 0 {
+				continue
+			}
+			// Standard deviation in pixels of exp(-pi²x²/b) with x in degrees.
+			sigma := math.Sqrt(g.b/(2*math.Pi*math.Pi)) * ppd
+			// All channels share the radius of the widest Gaussian, b = 0.04.
+			radius := int(math.Ceil(3 * math.Sqrt(0.04/(2*math.Pi*math.Pi)) * ppd))
+			k := gaussian(sigma, radius)
+			// Weight of the Gaussian in the discrete 2D kernel before normalization.
+			var sum float64
+			for i := -radius; i <= radius; i++ {
+				x := float64(i) / ppd
+				sum += math.Exp(-math.Pi * math.Pi * x * x / g.b)
+			}
+			weight := g.a * math.Pi / g.b * sum * sum
+			blurred := convolve(src, width, height, k, k)
+			if filtered[c] == nil {
+				filtered[c] = make([]float64, len(src))
+			}
+			for i, v := range blurred {
+				filtered[c][i] += weight * v
+			}
+			total += weight
+		}
+		for i := range filtered[c] {
+			filtered[c][i] /= total
+		}
+	}
+	out := make([]float64, len(ycc))
+	for i := 0; i < len(out)/3; i++ {
+		r, g, b := xyzToLinear(ycxczToXYZ(filtered[0][i], filtered[1][i], filtered[2][i]))
+		out[3*i], out[3*i+1], out[3*i+2] = linearToYCxCz(clamp01(r), clamp01(g), clamp01(b))
+	}
+	return out
+}
+
+// featureDifference returns the difference of edges and points of every pixel,
+// detected on the achromatic channel with derivatives of a Gaussian.
+func featureDifference(ref, tst []float64, width, height int, ppd float64) []float64 {
+	sigma := 0.5 * flipW * ppd
+	radius := int(math.Ceil(3 * sigma))
+	g := gaussian(sigma, radius)
+	edge, point := make([]float64, len(g)), make([]float64, len(g))
+	for i := range g {
+		x := float64(i - radius)
+		edge[i] = -x * g[i]
+		point[i] = (x*x/(sigma*sigma) - 1) * g[i]
+	}
+	normalizeDerivative(edge)
+	normalizeDerivative(point)
+	features := func(ycc []float64) (edges, points []float64) {
+		y := channel(ycc, 0)
+		for i := range y {
+			y[i] = (y[i] + 16) / 116
+		}
+		ex, ey := convolve(y, width, height, edge, g), convolve(y, width, height, g, edge)
+		px, py := convolve(y, width, height, point, g), convolve(y, width, height, g, point)
+		for i := range ex {
+			ex[i] = math.Hypot(ex[i], ey[i])
+			px[i] = math.Hypot(px[i], py[i])
+		}
+		return ex, px
+	}
+	er, pr := features(ref)
+	et, pt := features(tst)
+	diff := make([]float64, len(er))
+	for i := range diff {
+		d := math.Max(math.Abs(er[i]-et[i]), math.Abs(pr[i]-pt[i]))
+		diff[i] = math.Pow(d/math.Sqrt2, flipQf)
+	}
+	return diff
+}
+
+// normalizeDerivative scales the positive weights of k to sum 1 and the negative ones to sum -1.
+func normalizeDerivative(k []float64) {
+	var pos, neg float64
+	for _, v := range k {
+		if v > 0 {
+			pos += v
+		} else {
+			neg -= v
+		}
+	}
+	for i, v := range k {
+		if v > 0 {
+			k[i] = v / pos
+		} else if neg > 0 {
+			k[i] = v / neg
+		}
+	}
+}
+
+// D65 reference white, the XYZ of linear RGB (1, 1, 1).
+const whiteX, whiteY, whiteZ = 0.950428545, 1.0, 1.088900371
+
+// toYCxCz converts interleaved sRGB encoded pixels to the linearized CIELab space YCxCz.
+func toYCxCz(pix []float64) []float64 {
+	out := make([]float64, len(pix))
+	for i := 0; i < len(pix); i += 3 {
+		out[i], out[i+1], out[i+2] = linearToYCxCz(srgbToLinear(pix[i]), srgbToLinear(pix[i+1]), srgbToLinear(pix[i+2]))
+	}
+	return out
+}
+
+func linearToYCxCz(r, g, b float64) (y, cx, cz float64) {
+	x, yy, z := linearToXYZ(r, g, b)
+	x, yy, z = x/whiteX, yy/whiteY, z/whiteZ
+	return 116*yy - 16, 500 * (x - yy), 200 * (yy - z)
+}
+
+func ycxczToXYZ(y, cx, cz float64) (x, yy, z float64) {
+	yy = (y + 16) / 116
+	x = cx/500 + yy
+	z = yy - cz/200
+	return x * whiteX, yy * whiteY, z * whiteZ
+}
+
+// toHuntLab converts YCxCz pixels to CIELab with the chroma scaled by
+// lightness to model the Hunt effect.
+func toHuntLab(ycc []float64) []float64 {
+	out := make([]float64, len(ycc))
+	for i := 0; i < len(ycc); i += 3 {
+		l, a, b := xyzToLab(ycxczToXYZ(ycc[i], ycc[i+1], ycc[i+2]))
+		out[i], out[i+1], out[i+2] = l, 0.01*l*a, 0.01*l*b
+	}
+	return out
+}
+
+// maxHyAB is the HyAB distance between pure green and pure blue,
+// the largest in the Hunt adjusted CIELab space of sRGB colors.
+func maxHyAB() float64 {
+	green := toHuntLab(toYCxCz([]float64{0, 1, 0}))
+	blue := toHuntLab(toYCxCz([]float64{0, 0, 1}))
+	return hyAB(green, blue)
+}
+
+// hyAB is the distance of two CIELab colors, more accurate than the
+// Euclidean distance for large color differences.
+func hyAB(a, b []float64) float64 {
+	return math.Abs(a[0]-b[0]) + math.Hypot(a[1]-b[1], a[2]-b[2])
+}
+
+func xyzToLab(x, y, z float64) (l, a, b float64) {
+	const delta = 6.0 / 29
+	f := func(t float64) float64 {
+		if t > delta*delta*delta {
+			return math.Cbrt(t)
+		}
+		return t/(3*delta*delta) + 4.0/29
+	}
+	fx, fy, fz := f(x/whiteX), f(y/whiteY), f(z/whiteZ)
+	return 116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)
+}
+
+func srgbToLinear(c float64) float64 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+func linearToXYZ(r, g, b float64) (x, y, z float64) {
+	x = 0.4124564*r + 0.3575761*g + 0.1804375*b
+	y = 0.2126729*r + 0.7151522*g + 0.0721750*b
+	z = 0.0193339*r + 0.1191920*g + 0.9503041*b
+	return x, y, z
+}
+
+func xyzToLinear(x, y, z float64) (r, g, b float64) {
+	r = 3.2404542*x - 1.5371385*y - 0.4985314*z
+	g = -0.9692660*x + 1.8760108*y + 0.0415560*z
+	b = 0.0556434*x - 0.2040259*y + 1.0572252*z
+	return r, g, b
+}
@@ -0,0 +1,215 @@
+// Package imagediff measures differences between images: mean squared error,
+// peak signal to noise ratio, structural similarity (SSIM) and a perceptual
+// difference modeled after NVIDIA's FLIP. Per pixel differences are returned
+// as an ErrorMap which may be visualized as a heatmap.
+//
+// Everything runs on the CPU so results do not depend on the OpenGL driver.
+//
+//	flip, err := imagediff.FLIP(golden, rendered, 0)
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Println("mean FLIP error:", flip.Mean())
+//	err = png.Encode(w, flip.Heatmap())
+package imagediff
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+)
+
+var (
+	// ErrSizeMismatch is returned when comparing images of different sizes.
+	ErrSizeMismatch = errors.New("imagediff: images have different sizes")
+	// ErrEmpty is returned when comparing images with no pixels.
+	ErrEmpty = errors.New("imagediff: images are empty")
+)
+
+// ErrorMap holds a per pixel error, usually in the range [0, 1].
+type ErrorMap struct {
+	Width, Height int
+	// Values has Width*Height errors in row major order starting at the top left.
+	Values []float64
+}
+
+// At returns the error of pixel x, y.
+func (m *ErrorMap) At(x, y int) float64 { return m.Values[y*m.Width+x] }
+
+// Mean returns the mean error of all pixels.
+func (m *ErrorMap) Mean() float64 {
+	if len(m.Values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range m.Values {
+		sum += v
+	}
+	return sum / float64(len(m.Values))
+}
+
+// Max returns the largest error.
+func (m *ErrorMap) Max() float64 {
+	var max float64
+	for _, v := range m.Values {
+		max = math.Max(max, v)
+	}
+	return max
+}
+
+// Heatmap maps errors in [0, 1] to colors of the magma color map, from black
+// for no error through purple and orange to pale yellow for the largest error.
+// Values outside the range are clamped.
+func (m *ErrorMap) Heatmap() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, m.Width, m.Height))
+	for i, v := range m.Values {
+		c := magma(v)
+		img.Pix[4*i+0] = c.R
+		img.Pix[4*i+1] = c.G
+		img.Pix[4*i+2] = c.B
+		img.Pix[4*i+3] = 255
+	}
+	return img
+}
+
+// magmaStops samples the magma color map at nine evenly spaced points.
+var magmaStops = [...][3]float64{
+	{0, 0, 4}, {28, 16, 68}, {79, 18, 123}, {129, 37, 129}, {181, 54, 122},
+	{229, 80, 100}, {251, 135, 97}, {254, 194, 135}, {252, 253, 191},
+}
+
+func magma(v float64) color.RGBA {
+	v = clamp01(v) * float64(len(magmaStops)-1)
+	i := int(v)
+	if i == len(magmaStops)-1 {
+		i--
+	}
+	t := v - float64(i)
+	a, b := magmaStops[i], magmaStops[i+1]
+	lerp := func(j int) uint8 { return uint8(math.Round(a[j] + t*(b[j]-a[j]))) }
+	return color.RGBA{R: lerp(0), G: lerp(1), B: lerp(2), A: 255}
+}
+
+// MSE returns the mean squared error of the red, green and blue channels
+// in the range [0, 1]. Colors are composited over black, so transparent
+// pixels compare as black.
+func MSE(a, b image.Image) (float64, error) {
+	pa, pb, _, _, err := rgbPair(a, b)
+	if err != nil {
+		return 0, err
+	}
+	var sum float64
+	for i := range pa {
+		d := pa[i] - pb[i]
+		sum += d * d
+	}
+	return sum / float64(len(pa)), nil
+}
+
+// PSNR returns the peak signal to noise ratio in decibels, computed from MSE with a peak
+// value of 1. Larger is better, identical images return positive infinity.
+func PSNR(a, b image.Image) (float64, error) {
+	mse, err := MSE(a, b)
+	if err != nil {
+		return 0, err
+	}
+	if mse == 0 {
+		return math.Inf(1), nil
+	}
+	return -10 * math.Log10(mse), nil
+}
+
+// rgbPair returns the interleaved sRGB encoded red, green and blue
+// values in [0, 1] of two non-empty images of the same size.
+func rgbPair(a, b image.Image) (pa, pb []float64, width, height int, err error) {
+	size := a.Bounds().Size()
+	if size != b.Bounds().Size() {
+		return nil, nil, 0, 0, ErrSizeMismatch
+	}
+	if size.X <= 0 || size.Y <= 0 {
+		return nil, nil, 0, 0, ErrEmpty
+	}
+	return rgb(a), rgb(b), size.X, size.Y, nil
+}
+
+func rgb(img image.Image) []float64 {
+	bounds := img.Bounds()
+	pix := make([]float64, 0, 3*bounds.Dx()*bounds.Dy())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			// Premultiplied, which is the same as compositing over black.
+			r, g, b, _ := img.At(x, y).RGBA()
+			pix = append(pix, float64(r)/0xffff, float64(g)/0xffff, float64(b)/0xffff)
+		}
+	}
+	return pix
+}
+
+// channel extracts channel c of interleaved 3 channel pixels.
+func channel(pix []float64, c int) []float64 {
+	out := make([]float64, len(pix)/3)
+	for i := range out {
+		out[i] = pix[3*i+c]
+	}
+	return out
+}
+
+// convolve convolves the width*height single channel image src with the
+// separable kernel k along x and ky along y. Edges are clamped.
+func convolve(src []float64, width, height int, kx, ky []float64) []float64 {
+	tmp := make([]float64, len(src))
+	r := len(kx) / 2
+	for y := 0; y < height; y++ {
+		row := src[y*width : (y+1)*width]
+		for x := 0; x < width; x++ {
+			var sum float64
+			for i, w := range kx {
+				sum += w * row[clampInt(x+i-r, 0, width-1)]
+			}
+			tmp[y*width+x] = sum
+		}
+	}
+	dst := make([]float64, len(src))
+	r = len(ky) / 2
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var sum float64
+			for i, w := range ky {
+				sum += w * tmp[clampInt(y+i-r, 0, height-1)*width+x]
+			}
+			dst[y*width+x] = sum
+		}
+	}
+	return dst
+}
+
+// gaussian returns a normalized Gaussian kernel with standard deviation sigma
+// in pixels and the given radius.
+func gaussian(sigma float64, radius int) []float64 {
+	k := make([]float64, 2*radius+1)
+	var sum float64
+	for i := range k {
+		x := float64(i - radius)
+		k[i] = math.Exp(-x * x / (2 * sigma * sigma))
+		sum += k[i]
+	}
+	for i := range k {
+		k[i] /= sum
+	}
+	return k
+}
+
+func clampInt(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
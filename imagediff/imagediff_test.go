@@ -0,0 +1,124 @@
+package imagediff
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+	"testing"
+)
+
+// uniform returns a width by height image filled with c.
+func uniform(width, height int, c color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i+0], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = c.R, c.G, c.B, c.A
+	}
+	return img
+}
+
+// gradient returns an image with structure so SSIM and FLIP have edges to compare.
+func gradient(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetRGBA(x, y, color.RGBA{R: uint8(16 * x), G: uint8(16 * y), B: uint8(8 * (x ^ y)), A: 255})
+		}
+	}
+	return img
+}
+
+func TestMetrics(t *testing.T) {
+	gray := color.RGBA{R: 100, G: 100, B: 100, A: 255}
+	lighter := color.RGBA{R: 110, G: 110, B: 110, A: 255}
+	offsetMSE := (10.0 / 255) * (10.0 / 255)
+	tests := []struct {
+		name      string
+		a, b      image.Image
+		mse       float64
+		psnr      float64
+		identical bool
+	}{
+		{name: "identical uniform", a: uniform(8, 8, gray), b: uniform(8, 8, gray), psnr: math.Inf(1), identical: true},
+		{name: "identical gradient", a: gradient(16, 16), b: gradient(16, 16), psnr: math.Inf(1), identical: true},
+		{name: "uniform offset", a: uniform(8, 8, gray), b: uniform(8, 8, lighter), mse: offsetMSE, psnr: 20 * math.Log10(25.5)},
+		{
+			// Bounds need not start at the origin, only sizes must match.
+			name: "offset bounds", a: uniform(8, 8, gray).SubImage(image.Rect(2, 2, 6, 6)), b: uniform(4, 4, lighter),
+			mse: offsetMSE, psnr: 20 * math.Log10(25.5),
+		},
+		{
+			// Transparent pixels compare as black.
+			name: "transparent", a: uniform(4, 4, color.RGBA{}), b: uniform(4, 4, color.RGBA{A: 255}), psnr: math.Inf(1), identical: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mse, err := MSE(test.a, test.b)
+			if err != nil || math.Abs(mse-test.mse) > 1e-12 {
+				t.Errorf("MSE: got %v, %v; want %v", mse, err, test.mse)
+			}
+			psnr, err := PSNR(test.a, test.b)
+			if err != nil || !(psnr == test.psnr || math.Abs(psnr-test.psnr) < 1e-9) {
+				t.Errorf("PSNR: got %v, %v; want %v", psnr, err, test.psnr)
+			}
+			ssim, err := SSIM(test.a, test.b)
+			if err != nil || test.identical && math.Abs(ssim-1) > 1e-9 || !test.identical && ssim >= 1 {
+				t.Errorf("SSIM: got %v, %v; identical=%v", ssim, err, test.identical)
+			}
+			flip, err := FLIP(test.a, test.b, 0)
+			if err != nil {
+				t.Fatalf("FLIP: %v", err)
+			}
+			if mean := flip.Mean(); test.identical && mean != 0 || !test.identical && (mean <= 0 || mean > 1) {
+				t.Errorf("FLIP: got mean %v; identical=%v", mean, test.identical)
+			}
+		})
+	}
+}
+
+func TestMetricsErrors(t *testing.T) {
+	empty := image.NewRGBA(image.Rectangle{})
+	tests := []struct {
+		name string
+		a, b image.Image
+		want error
+	}{
+		{name: "size mismatch", a: uniform(4, 4, color.RGBA{}), b: uniform(4, 5, color.RGBA{}), want: ErrSizeMismatch},
+		{name: "empty", a: empty, b: empty, want: ErrEmpty},
+		{name: "empty rows", a: image.NewRGBA(image.Rect(0, 0, 4, 0)), b: image.NewRGBA(image.Rect(0, 0, 4, 0)), want: ErrEmpty},
+		{name: "one empty", a: empty, b: uniform(1, 1, color.RGBA{}), want: ErrSizeMismatch},
+	}
+	metrics := []struct {
+		name string
+		f    func(a, b image.Image) error
+	}{
+		{"MSE", func(a, b image.Image) error { _, err := MSE(a, b); return err }},
+		{"PSNR", func(a, b image.Image) error { _, err := PSNR(a, b); return err }},
+		{"SSIM", func(a, b image.Image) error { _, err := SSIM(a, b); return err }},
+		{"SSIMMap", func(a, b image.Image) error { _, err := SSIMMap(a, b); return err }},
+		{"FLIP", func(a, b image.Image) error { _, err := FLIP(a, b, 0); return err }},
+	}
+	for _, test := range tests {
+		for _, metric := range metrics {
+			if err := metric.f(test.a, test.b); !errors.Is(err, test.want) {
+				t.Errorf("%s: %s: got %v, want %v", test.name, metric.name, err, test.want)
+			}
+		}
+	}
+}
+
+func TestHeatmap(t *testing.T) {
+	m := &ErrorMap{Width: 2, Height: 1, Values: []float64{0, 2}}
+	if m.Max() != 2 || m.Mean() != 1 {
+		t.Errorf("got max %v and mean %v", m.Max(), m.Mean())
+	}
+	img := m.Heatmap()
+	if got := img.RGBAAt(0, 0); got != (color.RGBA{0, 0, 4, 255}) {
+		t.Errorf("no error: got %v", got)
+	}
+	// Values above 1 are clamped to the last color of the map.
+	if got := img.RGBAAt(1, 0); got != (color.RGBA{252, 253, 191, 255}) {
+		t.Errorf("largest error: got %v", got)
+	}
+}
@@ -0,0 +1,73 @@
+package imagediff
+
+import "image"
+
+// SSIM constants of Wang et al. 2004 for values in [0, 1].
+const (
+	ssimSigma  = 1.5
+	ssimRadius = 5
+	ssimC1     = 0.01 * 0.01
+	ssimC2     = 0.03 * 0.03
+)
+
+// SSIM returns the mean structural similarity index of the luma of two images,
+// computed with an 11x11 Gaussian window. It is 1 for identical images and
+// decreases as structure, contrast or brightness differ.
+func SSIM(a, b image.Image) (float64, error) {
+	local, width, height, err := ssim(a, b)
+	if err != nil {
+		return 0, err
+	}
+	m := ErrorMap{Width: width, Height: height, Values: local}
+	return m.Mean(), nil
+}
+
+// SSIMMap returns the dissimilarity 1-SSIM of every pixel, clamped to [0, 1].
+func SSIMMap(a, b image.Image) (*ErrorMap, error) {
+	local, width, height, err := ssim(a, b)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range local {
+		local[i] = clamp01(1 - v)
+	}
+	return &ErrorMap{Width: width, Height: height, Values: local}, nil
+}
+
+// ssim returns the local SSIM of every pixel.
+func ssim(a, b image.Image) (local []float64, width, height int, err error) {
+	pa, pb, width, height, err := rgbPair(a, b)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	x, y := luma(pa), luma(pb)
+	n := len(x)
+	xx, yy, xy := make([]float64, n), make([]float64, n), make([]float64, n)
+	for i := range x {
+		xx[i] = x[i] * x[i]
+		yy[i] = y[i] * y[i]
+		xy[i] = x[i] * y[i]
+	}
+	k := gaussian(ssimSigma, ssimRadius)
+	blur := func(v []float64) []float64 { return convolve(v, width, height, k, k) }
+	mx, my := blur(x), blur(y)
+	sxx, syy, sxy := blur(xx), blur(yy), blur(xy)
+	local = make([]float64, n)
+	for i := range local {
+		varX := sxx[i] - mx[i]*mx[i]
+		varY := syy[i] - my[i]*my[i]
+		cov := sxy[i] - mx[i]*my[i]
+		local[i] = (2*mx[i]*my[i] + ssimC1) * (2*cov + ssimC2) /
+			((mx[i]*mx[i] + my[i]*my[i] + ssimC1) * (varX + varY + ssimC2))
+	}
+	return local, width, height, nil
+}
+
+// luma returns the Rec. 709 luma of interleaved sRGB encoded pixels.
+func luma(pix []float64) []float64 {
+	out := make([]float64, len(pix)/3)
+	for i := range out {
+		out[i] = 0.2126*pix[3*i] + 0.7152*pix[3*i+1] + 0.0722*pix[3*i+2]
+	}
+	return out
+}
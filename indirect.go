@@ -0,0 +1,158 @@
+package shaders
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// DrawElementsIndirectCommand is the layout OpenGL expects for each command
+// in an indirect buffer when drawing indexed geometry. Each command is an
+// instanced draw of a range of the bound index buffer.
+type DrawElementsIndirectCommand struct {
+	// Count is the number of indices to draw.
+	Count uint32
+	// InstanceCount is the number of instances to draw. Zero skips the command.
+	InstanceCount uint32
+	// FirstIndex is the index of the first index in the index buffer.
+	FirstIndex uint32
+	// BaseVertex is added to each index before fetching vertices.
+	BaseVertex int32
+	// BaseInstance is the first element read from per-instance attributes.
+	BaseInstance uint32
+}
+
+// IndirectBuffer holds draw commands in GPU memory. Since the commands
+// live in a buffer object they may be generated by a compute shader, in which
+// case the shader declares the buffer with the same layout as DrawElementsIndirectCommand:
+//
+//	struct DrawCommand {
+//		uint count;
+//		uint instanceCount;
+//		uint firstIndex;
+//		int  baseVertex;
+//		uint baseInstance;
+//	};
+//	layout(std430, binding = 0) buffer Commands {
+//		DrawCommand cmds[];
+//	};
+type IndirectBuffer struct {
+	// Renderer ID. If using OpenGL is the id set on buffer creation.
+	rid uint32
+	// count is the number of commands the buffer holds.
+	count int32
+}
+
+// NewIndirectBuffer creates a buffer with the draw commands given.
+func NewIndirectBuffer(cmds []DrawElementsIndirectCommand) (IndirectBuffer, error) {
+	if len(cmds) == 0 {
+		return IndirectBuffer{}, errors.New("empty indirect command buffer")
+	}
+	buf := IndirectBuffer{count: int32(len(cmds))}
+	const cmdSize = unsafe.Sizeof(cmds[0])
+	gl.GenBuffers(1, &buf.rid)
+	trackCreate(kindBuffer, buf.rid)
+	gl.BindBuffer(gl.DRAW_INDIRECT_BUFFER, buf.rid)
+	if err := checkCall("glBindBuffer"); err != nil {
+		return buf, err
+	}
+	gl.BufferData(gl.DRAW_INDIRECT_BUFFER, int(cmdSize)*len(cmds), unsafe.Pointer(&cmds[0]), gl.DYNAMIC_DRAW)
+	return buf, checkErrorAfter("glBufferData")
+}
+
+// Update overwrites the commands starting at command offset with cmds.
+func (buf IndirectBuffer) Update(offset int, cmds []DrawElementsIndirectCommand) error {
+	if offset < 0 || offset+len(cmds) > int(buf.count) {
+		return errors.New("indirect command update out of range")
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	const cmdSize = unsafe.Sizeof(cmds[0])
+	buf.Bind()
+	gl.BufferSubData(gl.DRAW_INDIRECT_BUFFER, offset*int(cmdSize), len(cmds)*int(cmdSize), unsafe.Pointer(&cmds[0]))
+	return checkErrorAfter("glBufferSubData")
+}
+
+// Count returns the number of commands in the buffer.
+func (buf IndirectBuffer) Count() int { return int(buf.count) }
+
+func (buf IndirectBuffer) Bind() {
+	trackUse(kindBuffer, buf.rid)
+	gl.BindBuffer(gl.DRAW_INDIRECT_BUFFER, buf.rid)
+	logCall("glBindBuffer")
+}
+func (buf IndirectBuffer) Unbind() {
+	gl.BindBuffer(gl.DRAW_INDIRECT_BUFFER, 0)
+}
+func (buf IndirectBuffer) Delete() {
+	if trackDelete(kindBuffer, buf.rid) {
+		gl.DeleteBuffers(1, &buf.rid)
+	}
+}
+
+// BindBase binds the buffer to the shader storage buffer binding point index
+// so that a compute shader may write the commands.
+func (buf IndirectBuffer) BindBase(index int) {
+	trackUse(kindBuffer, buf.rid)
+	gl.BindBufferBase(gl.SHADER_STORAGE_BUFFER, uint32(index), buf.rid)
+	logCall("glBindBufferBase")
+}
+
+// MultiDrawIndirect binds the vertex array, index buffer and program and submits all
+// commands in cmds with a single call. Every command draws from the same vertex array
+// so meshes are usually packed into shared buffers and told apart with FirstIndex and BaseVertex.
+// It returns ErrUnsupported if the context is older than OpenGL 4.3 and lacks ARB_multi_draw_indirect.
+func (r *Renderer) MultiDrawIndirect(vao VertexArray, ib IndexBuffer, prog Program, cmds IndirectBuffer) error {
+	if !r.multiDrawIndirect {
+		return ErrUnsupported
+	}
+	if cmds.rid == 0 {
+		return errors.New("draw with no indirect buffer")
+	}
+	if err := r.bind(vao, prog); err != nil {
+		return err
+	}
+	if err := bindIndices(ib); err != nil {
+		return err
+	}
+	cmds.Bind()
+	// With a draw indirect buffer bound the indirect argument is an offset into the buffer.
+	gl.MultiDrawElementsIndirect(r.primitive, gl.UNSIGNED_INT, nil, cmds.count, 0)
+	return checkErrorAfter("glMultiDrawElementsIndirect")
+}
+
+// NewComputeProgram compiles a null terminated compute shader source into a Program.
+func NewComputeProgram(computeSrc string) (prog Program, err error) {
+	prog.rid, err = CompileCompute(computeSrc)
+	trackCreate(kindProgram, prog.rid)
+	return prog, err
+}
+
+// Dispatch binds the compute program and launches x*y*z work groups. Writes
+// done by the shader are not guaranteed to be visible to subsequent commands
+// until a call to MemoryBarrier. It returns ErrUnsupported if the context lacks
+// compute shaders.
+func (p Program) Dispatch(x, y, z int) error {
+	if p.rid == 0 {
+		return ErrNoProgram
+	}
+	if !hasCompute() {
+		return ErrUnsupported
+	}
+	p.Bind()
+	gl.DispatchCompute(uint32(x), uint32(y), uint32(z))
+	return checkErrorAfter("glDispatchCompute")
+}
+
+// MemoryBarrier orders memory transactions issued before the call relative to
+// those issued after it. After generating commands in a compute shader
+// call MemoryBarrier(gl.COMMAND_BARRIER_BIT) before MultiDrawIndirect.
+// It is a no-op if the context does not support compute shaders.
+func MemoryBarrier(barriers uint32) {
+	if !hasCompute() {
+		return
+	}
+	gl.MemoryBarrier(barriers)
+}
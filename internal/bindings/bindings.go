@@ -0,0 +1,46 @@
+//go:build !gl33 && !gl41 && !gles30
+
+package bindings
+
+import (
+	"unsafe"
+
+	gles2 "github.com/go-gl/gl/v3.0/gles2"
+	glv33 "github.com/go-gl/gl/v3.3-core/gl"
+	glv41 "github.com/go-gl/gl/v4.1-core/gl"
+	glv46 "github.com/go-gl/gl/v4.6-core/gl"
+	"github.com/soypat/shaders"
+	"github.com/soypat/shaders/backend/gl33"
+	"github.com/soypat/shaders/backend/gl41"
+	"github.com/soypat/shaders/backend/gl46"
+	"github.com/soypat/shaders/backend/gles30"
+)
+
+// Init initializes the newest go-gl bindings the current context supports and
+// sets the matching backend of package shaders: the OpenGL ES 3.0 bindings if
+// es is set, else the first of the OpenGL 4.6, 4.1 and 3.3 core bindings whose
+// functions the context provides. Builds with the gl33, gl41 or gles30 tag only
+// initialize the bindings of the backend selected by the tag.
+func Init(es bool, getProcAddr func(string) unsafe.Pointer) error {
+	if es {
+		if err := gles2.InitWithProcAddrFunc(getProcAddr); err != nil {
+			return err
+		}
+		shaders.SetBackend(gles30.Backend{})
+		return nil
+	}
+	// Bindings fail to initialize on contexts older than their version, i.e: macOS stops at 4.1.
+	if err := glv46.InitWithProcAddrFunc(getProcAddr); err == nil {
+		shaders.SetBackend(gl46.Backend{})
+		return nil
+	}
+	if err := glv41.InitWithProcAddrFunc(getProcAddr); err == nil {
+		shaders.SetBackend(gl41.Backend{})
+		return nil
+	}
+	if err := glv33.InitWithProcAddrFunc(getProcAddr); err != nil {
+		return err
+	}
+	shaders.SetBackend(gl33.Backend{})
+	return nil
+}
@@ -0,0 +1,24 @@
+//go:build gl33
+
+package bindings
+
+import (
+	"errors"
+	"unsafe"
+
+	glv33 "github.com/go-gl/gl/v3.3-core/gl"
+	"github.com/soypat/shaders"
+)
+
+// Init initializes the OpenGL 3.3 core bindings of the backend selected by the
+// gl33 build tag, which is left as the backend of package shaders.
+func Init(es bool, getProcAddr func(string) unsafe.Pointer) error {
+	if es {
+		return errors.New("built with the gl33 tag, which can not use OpenGL ES contexts")
+	}
+	if err := glv33.InitWithProcAddrFunc(getProcAddr); err != nil {
+		return err
+	}
+	shaders.InvalidateContext()
+	return nil
+}
@@ -0,0 +1,24 @@
+//go:build gl41
+
+package bindings
+
+import (
+	"errors"
+	"unsafe"
+
+	glv41 "github.com/go-gl/gl/v4.1-core/gl"
+	"github.com/soypat/shaders"
+)
+
+// Init initializes the OpenGL 4.1 core bindings of the backend selected by the
+// gl41 build tag, which is left as the backend of package shaders.
+func Init(es bool, getProcAddr func(string) unsafe.Pointer) error {
+	if es {
+		return errors.New("built with the gl41 tag, which can not use OpenGL ES contexts")
+	}
+	if err := glv41.InitWithProcAddrFunc(getProcAddr); err != nil {
+		return err
+	}
+	shaders.InvalidateContext()
+	return nil
+}
@@ -0,0 +1,24 @@
+//go:build gles30
+
+package bindings
+
+import (
+	"errors"
+	"unsafe"
+
+	gles2 "github.com/go-gl/gl/v3.0/gles2"
+	"github.com/soypat/shaders"
+)
+
+// Init initializes the OpenGL ES 3.0 bindings of the backend selected by the
+// gles30 build tag, which is left as the backend of package shaders.
+func Init(es bool, getProcAddr func(string) unsafe.Pointer) error {
+	if !es {
+		return errors.New("built with the gles30 tag, which can only use OpenGL ES contexts")
+	}
+	if err := gles2.InitWithProcAddrFunc(getProcAddr); err != nil {
+		return err
+	}
+	shaders.InvalidateContext()
+	return nil
+}
@@ -0,0 +1,3 @@
+// Package bindings initializes the go-gl bindings of an OpenGL context created
+// by the module's window and headless context helpers.
+package bindings
@@ -0,0 +1,285 @@
+// Command genbackend generates the glue between package shaders and
+// its OpenGL backends from the backend.Backend interface definition.
+//
+// With -mode=shim it generates the functions of package internal/gl which
+// forward every call to the current backend, with -mode=consts the OpenGL
+// enum constants of package internal/gl and with -mode=adapter the methods
+// of a backend implementation that forward to a go-gl bindings package.
+// Methods already defined by hand in the adapter's package are not generated.
+// Functions the bindings lack are forwarded to their extension variant,
+// i.e: BlendFuncSeparatei to BlendFuncSeparateiARB, or panic if there is none.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	mode := flag.String("mode", "", "one of shim, consts or adapter")
+	iface := flag.String("iface", "../../backend/backend.go", "file with the Backend interface definition")
+	pkg := flag.String("pkg", "github.com/go-gl/gl/v4.6-core/gl", "go-gl bindings package")
+	output := flag.String("o", "", "output file")
+	flag.Parse()
+	var src []byte
+	var err error
+	switch *mode {
+	case "shim":
+		src, err = genShim(*iface)
+	case "consts":
+		src, err = genConsts(*pkg)
+	case "adapter":
+		src, err = genAdapter(*iface, *pkg, *output)
+	default:
+		err = fmt.Errorf("unknown mode %q", *mode)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+const backendPkg = "github.com/soypat/shaders/backend"
+
+type method struct {
+	name    string
+	fn      *ast.FuncType
+	hasRes  bool
+	argList string
+}
+
+// parseInterface returns the methods of the Backend interface in filename.
+func parseInterface(filename string) ([]method, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	obj := f.Scope.Lookup("Backend")
+	if obj == nil {
+		return nil, fmt.Errorf("no Backend interface in %s", filename)
+	}
+	it, ok := obj.Decl.(*ast.TypeSpec).Type.(*ast.InterfaceType)
+	if !ok {
+		return nil, fmt.Errorf("Backend in %s is not an interface", filename)
+	}
+	var methods []method
+	for _, field := range it.Methods.List {
+		fn := field.Type.(*ast.FuncType)
+		var args []string
+		for _, p := range fn.Params.List {
+			for _, name := range p.Names {
+				args = append(args, name.Name)
+			}
+		}
+		methods = append(methods, method{
+			name:    field.Names[0].Name,
+			fn:      fn,
+			hasRes:  fn.Results != nil && len(fn.Results.List) > 0,
+			argList: strings.Join(args, ", "),
+		})
+	}
+	return methods, nil
+}
+
+// signature returns the parameters and results of fn. Identifiers of
+// types declared in package backend are qualified with qualifier if not empty.
+func signature(fn *ast.FuncType, qualifier string) string {
+	if qualifier != "" {
+		ast.Inspect(fn, func(n ast.Node) bool {
+			field, ok := n.(*ast.Field)
+			if !ok {
+				return true
+			}
+			if id, ok := field.Type.(*ast.Ident); ok && ast.IsExported(id.Name) {
+				field.Type = &ast.SelectorExpr{X: ast.NewIdent(qualifier), Sel: id}
+			}
+			return true
+		})
+	}
+	var buf bytes.Buffer
+	printer.Fprint(&buf, token.NewFileSet(), fn)
+	return strings.TrimPrefix(buf.String(), "func")
+}
+
+func genShim(iface string) ([]byte, error) {
+	methods, err := parseInterface(iface)
+	if err != nil {
+		return nil, err
+	}
+	var body bytes.Buffer
+	for _, m := range methods {
+		ret := ""
+		if m.hasRes {
+			ret = "return "
+		}
+		fmt.Fprintf(&body, "func %s%s {\n\t%scurrent.%s(%s)\n}\n\n", m.name, signature(m.fn, "backend"), ret, m.name, m.argList)
+	}
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by genbackend -mode=shim; DO NOT EDIT.\n\n")
+	buf.WriteString("package gl\n\n")
+	writeImports(&buf, body.Bytes(), map[string]string{"backend": backendPkg})
+	buf.Write(body.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+func genConsts(pkg string) ([]byte, error) {
+	f, err := parseBindings(pkg)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by genbackend -mode=consts from %s; DO NOT EDIT.\n\n", pkg)
+	buf.WriteString("package gl\n\nconst (\n")
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if len(vs.Names) != 1 || len(vs.Values) != 1 {
+				continue
+			}
+			lit, ok := vs.Values[0].(*ast.BasicLit)
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(&buf, "\t%s = %s\n", vs.Names[0].Name, lit.Value)
+		}
+	}
+	buf.WriteString(")\n")
+	return format.Source(buf.Bytes())
+}
+
+func genAdapter(iface, pkg, output string) ([]byte, error) {
+	methods, err := parseInterface(iface)
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := parseBindings(pkg)
+	if err != nil {
+		return nil, err
+	}
+	// Methods written by hand are the ones whose signature differs from go-gl.
+	handWritten := make(map[string]bool)
+	dir := filepath.Dir(output)
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi os.FileInfo) bool {
+		return fi.Name() != filepath.Base(output)
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	var pkgName string
+	for name, p := range pkgs {
+		pkgName = name
+		for _, f := range p.Files {
+			for _, decl := range f.Decls {
+				if fd, ok := decl.(*ast.FuncDecl); ok && fd.Recv != nil {
+					handWritten[fd.Name.Name] = true
+				}
+			}
+		}
+	}
+	if pkgName == "" {
+		return nil, fmt.Errorf("no hand written Backend type in %s", dir)
+	}
+
+	var body bytes.Buffer
+	for _, m := range methods {
+		if handWritten[m.name] {
+			continue
+		}
+		sig := signature(m.fn, "backend")
+		name := bindingName(bindings, m.name)
+		if name == "" {
+			fmt.Fprintf(&body, "func (Backend) %s%s {\n\tpanic(%q)\n}\n\n", m.name, sig, "gl"+m.name+" not available in "+pkg)
+			continue
+		}
+		ret := ""
+		if m.hasRes {
+			ret = "return "
+		}
+		fmt.Fprintf(&body, "func (Backend) %s%s {\n\t%sgl.%s(%s)\n}\n\n", m.name, sig, ret, name, m.argList)
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by genbackend -mode=adapter from %s; DO NOT EDIT.\n\n", pkg)
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	writeImports(&buf, body.Bytes(), map[string]string{"backend": backendPkg, "gl": pkg})
+	buf.Write(body.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+// extensionSuffixes are tried in order when the bindings lack a function.
+var extensionSuffixes = []string{"ARB", "EXT", "OES", "KHR"}
+
+// bindingName returns the name of the function in bindings implementing
+// the method name or the empty string if there is none.
+func bindingName(bindings *ast.File, name string) string {
+	if bindings.Scope.Lookup(name) != nil {
+		return name
+	}
+	for _, suffix := range extensionSuffixes {
+		if bindings.Scope.Lookup(name+suffix) != nil {
+			return name + suffix
+		}
+	}
+	return ""
+}
+
+// writeImports writes the import declaration of a generated file with body.
+// unsafe and the candidate packages, keyed by the name they are imported as,
+// are only imported if referenced by body.
+func writeImports(buf *bytes.Buffer, body []byte, candidates map[string]string) {
+	var imports []string
+	if bytes.Contains(body, []byte("unsafe.")) {
+		imports = append(imports, `"unsafe"`, "")
+	}
+	names := make([]string, 0, len(candidates))
+	for name := range candidates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !bytes.Contains(body, []byte(name+".")) {
+			continue
+		}
+		path := candidates[name]
+		if name == filepath.Base(path) {
+			imports = append(imports, strconv.Quote(path))
+		} else {
+			imports = append(imports, name+" "+strconv.Quote(path))
+		}
+	}
+	if len(imports) > 0 && imports[len(imports)-1] == "" {
+		imports = imports[:len(imports)-1]
+	}
+	if len(imports) == 0 {
+		return
+	}
+	fmt.Fprintf(buf, "import (\n\t%s\n)\n\n", strings.Join(imports, "\n\t"))
+}
+
+// parseBindings parses the package.go file of a go-gl bindings package.
+func parseBindings(pkg string) (*ast.File, error) {
+	dir, err := exec.Command("go", "list", "-f", "{{.Dir}}", pkg).Output()
+	if err != nil {
+		return nil, fmt.Errorf("locating %s: %w", pkg, err)
+	}
+	filename := filepath.Join(strings.TrimSpace(string(dir)), "package.go")
+	return parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+}
@@ -0,0 +1,111 @@
+// Command genenums generates the table of OpenGL enum names used by
+// package shaders to decode enum values in errors and logs.
+//
+// The enum names and values are read from the go-gl bindings source code
+// so the table is always in sync with the bindings the package uses.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// vendorSuffix matches enum names of vendor extensions such as TEXTURE_CUBE_MAP_SEAMLESS_ARB.
+var vendorSuffix = regexp.MustCompile(`_(ARB|EXT|KHR|NV|NVX|AMD|INTEL|OES|OVR|MESA|MESAX|APPLE|SGIS|SGIX|ATI|IBM|SUN|HP|PGI|QCOM|WIN|3DFX|GREMEDY|OML|S3|REND|INGR|ANGLE|IMG|ARM|VIV|DMP|FJ|SUNX|SGI)$`)
+
+func main() {
+	pkg := flag.String("pkg", "github.com/go-gl/gl/v4.6-core/gl", "go-gl bindings package to read enums from")
+	output := flag.String("o", "enumnames.go", "output file")
+	flag.Parse()
+	if err := run(*pkg, *output); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(pkg, output string) error {
+	dir, err := exec.Command("go", "list", "-f", "{{.Dir}}", pkg).Output()
+	if err != nil {
+		return fmt.Errorf("locating %s: %w", pkg, err)
+	}
+	filename := filepath.Join(strings.TrimSpace(string(dir)), "package.go")
+	f, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	if err != nil {
+		return err
+	}
+	names := make(map[uint32][]string)
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if len(vs.Values) != 1 {
+				continue
+			}
+			lit, ok := vs.Values[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.INT {
+				continue
+			}
+			v, err := strconv.ParseUint(lit.Value, 0, 64)
+			if err != nil || v > math.MaxUint32 {
+				continue // 64 bit values such as TIMEOUT_IGNORED.
+			}
+			for _, name := range vs.Names {
+				names[uint32(v)] = append(names[uint32(v)], name.Name)
+			}
+		}
+	}
+
+	values := make([]uint32, 0, len(names))
+	for v, vnames := range names {
+		values = append(values, v)
+		// Vendor aliases of core enums add noise to messages.
+		var core []string
+		for _, name := range vnames {
+			if !vendorSuffix.MatchString(name) {
+				core = append(core, name)
+			}
+		}
+		if len(core) > 0 {
+			vnames = core
+		}
+		sort.Strings(vnames)
+		names[v] = vnames
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by genenums from %s; DO NOT EDIT.\n\n", pkg)
+	buf.WriteString("package shaders\n\n")
+	buf.WriteString("// enumNames maps OpenGL enum values to the names of the enums with that value\n")
+	buf.WriteString("// without the GL_ prefix. Vendor aliases are omitted if a core enum shares the value.\n")
+	buf.WriteString("var enumNames = map[uint32][]string{\n")
+	for _, v := range values {
+		fmt.Fprintf(&buf, "\t0x%04X: {%q", v, names[v][0])
+		for _, name := range names[v][1:] {
+			fmt.Fprintf(&buf, ", %q", name)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(output, src, 0644)
+}
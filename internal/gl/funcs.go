@@ -0,0 +1,429 @@
+// Code generated by genbackend -mode=shim; DO NOT EDIT.
+
+package gl
+
+import (
+	"unsafe"
+
+	"github.com/soypat/shaders/backend"
+)
+
+func ActiveTexture(texture uint32) {
+	current.ActiveTexture(texture)
+}
+
+func AttachShader(program uint32, shader uint32) {
+	current.AttachShader(program, shader)
+}
+
+func BindBuffer(target uint32, buffer uint32) {
+	current.BindBuffer(target, buffer)
+}
+
+func BindBufferBase(target uint32, index uint32, buffer uint32) {
+	current.BindBufferBase(target, index, buffer)
+}
+
+func BindFragDataLocation(program uint32, color uint32, name *uint8) {
+	current.BindFragDataLocation(program, color, name)
+}
+
+func BindFramebuffer(target uint32, framebuffer uint32) {
+	current.BindFramebuffer(target, framebuffer)
+}
+
+func BindRenderbuffer(target uint32, renderbuffer uint32) {
+	current.BindRenderbuffer(target, renderbuffer)
+}
+
+func BindTexture(target uint32, texture uint32) {
+	current.BindTexture(target, texture)
+}
+
+func BindVertexArray(array uint32) {
+	current.BindVertexArray(array)
+}
+
+func BlendEquationSeparate(modeRGB uint32, modeAlpha uint32) {
+	current.BlendEquationSeparate(modeRGB, modeAlpha)
+}
+
+func BlendEquationSeparatei(buf uint32, modeRGB uint32, modeAlpha uint32) {
+	current.BlendEquationSeparatei(buf, modeRGB, modeAlpha)
+}
+
+func BlendFuncSeparate(sfactorRGB uint32, dfactorRGB uint32, sfactorAlpha uint32, dfactorAlpha uint32) {
+	current.BlendFuncSeparate(sfactorRGB, dfactorRGB, sfactorAlpha, dfactorAlpha)
+}
+
+func BlendFuncSeparatei(buf uint32, srcRGB uint32, dstRGB uint32, srcAlpha uint32, dstAlpha uint32) {
+	current.BlendFuncSeparatei(buf, srcRGB, dstRGB, srcAlpha, dstAlpha)
+}
+
+func BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	current.BufferData(target, size, data, usage)
+}
+
+func BufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	current.BufferSubData(target, offset, size, data)
+}
+
+func CheckFramebufferStatus(target uint32) uint32 {
+	return current.CheckFramebufferStatus(target)
+}
+
+func Clear(mask uint32) {
+	current.Clear(mask)
+}
+
+func ClearColor(red float32, green float32, blue float32, alpha float32) {
+	current.ClearColor(red, green, blue, alpha)
+}
+
+func ClearDepth(depth float64) {
+	current.ClearDepth(depth)
+}
+
+func ClearStencil(s int32) {
+	current.ClearStencil(s)
+}
+
+func ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32 {
+	return current.ClientWaitSync(sync, flags, timeout)
+}
+
+func ColorMask(red bool, green bool, blue bool, alpha bool) {
+	current.ColorMask(red, green, blue, alpha)
+}
+
+func CompileShader(shader uint32) {
+	current.CompileShader(shader)
+}
+
+func CreateProgram() uint32 {
+	return current.CreateProgram()
+}
+
+func CreateShader(xtype uint32) uint32 {
+	return current.CreateShader(xtype)
+}
+
+func CullFace(mode uint32) {
+	current.CullFace(mode)
+}
+
+func DebugMessageCallback(callback backend.DebugProc, userParam unsafe.Pointer) {
+	current.DebugMessageCallback(callback, userParam)
+}
+
+func DebugMessageControl(source uint32, xtype uint32, severity uint32, count int32, ids *uint32, enabled bool) {
+	current.DebugMessageControl(source, xtype, severity, count, ids, enabled)
+}
+
+func DeleteBuffers(n int32, buffers *uint32) {
+	current.DeleteBuffers(n, buffers)
+}
+
+func DeleteFramebuffers(n int32, framebuffers *uint32) {
+	current.DeleteFramebuffers(n, framebuffers)
+}
+
+func DeleteProgram(program uint32) {
+	current.DeleteProgram(program)
+}
+
+func DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	current.DeleteRenderbuffers(n, renderbuffers)
+}
+
+func DeleteShader(shader uint32) {
+	current.DeleteShader(shader)
+}
+
+func DeleteSync(sync uintptr) {
+	current.DeleteSync(sync)
+}
+
+func DeleteTextures(n int32, textures *uint32) {
+	current.DeleteTextures(n, textures)
+}
+
+func DeleteVertexArrays(n int32, arrays *uint32) {
+	current.DeleteVertexArrays(n, arrays)
+}
+
+func DepthFunc(xfunc uint32) {
+	current.DepthFunc(xfunc)
+}
+
+func DepthMask(flag bool) {
+	current.DepthMask(flag)
+}
+
+func DetachShader(program uint32, shader uint32) {
+	current.DetachShader(program, shader)
+}
+
+func Disable(cap uint32) {
+	current.Disable(cap)
+}
+
+func Disablei(target uint32, index uint32) {
+	current.Disablei(target, index)
+}
+
+func DispatchCompute(num_groups_x uint32, num_groups_y uint32, num_groups_z uint32) {
+	current.DispatchCompute(num_groups_x, num_groups_y, num_groups_z)
+}
+
+func DrawArrays(mode uint32, first int32, count int32) {
+	current.DrawArrays(mode, first, count)
+}
+
+func DrawArraysInstanced(mode uint32, first int32, count int32, instancecount int32) {
+	current.DrawArraysInstanced(mode, first, count, instancecount)
+}
+
+func DrawArraysInstancedBaseInstance(mode uint32, first int32, count int32, instancecount int32, baseinstance uint32) {
+	current.DrawArraysInstancedBaseInstance(mode, first, count, instancecount, baseinstance)
+}
+
+func DrawBuffers(n int32, bufs *uint32) {
+	current.DrawBuffers(n, bufs)
+}
+
+func DrawElementsInstanced(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32) {
+	current.DrawElementsInstanced(mode, count, xtype, indices, instancecount)
+}
+
+func DrawElementsInstancedBaseInstance(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32, baseinstance uint32) {
+	current.DrawElementsInstancedBaseInstance(mode, count, xtype, indices, instancecount, baseinstance)
+}
+
+func DrawElementsWithOffset(mode uint32, count int32, xtype uint32, indices uintptr) {
+	current.DrawElementsWithOffset(mode, count, xtype, indices)
+}
+
+func Enable(cap uint32) {
+	current.Enable(cap)
+}
+
+func EnableVertexAttribArray(index uint32) {
+	current.EnableVertexAttribArray(index)
+}
+
+func Enablei(target uint32, index uint32) {
+	current.Enablei(target, index)
+}
+
+func FenceSync(condition uint32, flags uint32) uintptr {
+	return current.FenceSync(condition, flags)
+}
+
+func FramebufferRenderbuffer(target uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32) {
+	current.FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer)
+}
+
+func FramebufferTexture2D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	current.FramebufferTexture2D(target, attachment, textarget, texture, level)
+}
+
+func FrontFace(mode uint32) {
+	current.FrontFace(mode)
+}
+
+func GenBuffers(n int32, buffers *uint32) {
+	current.GenBuffers(n, buffers)
+}
+
+func GenerateMipmap(target uint32) {
+	current.GenerateMipmap(target)
+}
+
+func GenFramebuffers(n int32, framebuffers *uint32) {
+	current.GenFramebuffers(n, framebuffers)
+}
+
+func GenRenderbuffers(n int32, renderbuffers *uint32) {
+	current.GenRenderbuffers(n, renderbuffers)
+}
+
+func GenTextures(n int32, textures *uint32) {
+	current.GenTextures(n, textures)
+}
+
+func GenVertexArrays(n int32, arrays *uint32) {
+	current.GenVertexArrays(n, arrays)
+}
+
+func GetAttribLocation(program uint32, name *uint8) int32 {
+	return current.GetAttribLocation(program, name)
+}
+
+func GetError() uint32 {
+	return current.GetError()
+}
+
+func GetIntegeri_v(target uint32, index uint32, data *int32) {
+	current.GetIntegeri_v(target, index, data)
+}
+
+func GetIntegerv(pname uint32, data *int32) {
+	current.GetIntegerv(pname, data)
+}
+
+func GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	current.GetProgramInfoLog(program, bufSize, length, infoLog)
+}
+
+func GetProgramiv(program uint32, pname uint32, params *int32) {
+	current.GetProgramiv(program, pname, params)
+}
+
+func GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	current.GetShaderInfoLog(shader, bufSize, length, infoLog)
+}
+
+func GetShaderiv(shader uint32, pname uint32, params *int32) {
+	current.GetShaderiv(shader, pname, params)
+}
+
+func GetString(name uint32) *uint8 {
+	return current.GetString(name)
+}
+
+func GetStringi(name uint32, index uint32) *uint8 {
+	return current.GetStringi(name, index)
+}
+
+func GetTexImage(target uint32, level int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	current.GetTexImage(target, level, format, xtype, pixels)
+}
+
+func GetUniformLocation(program uint32, name *uint8) int32 {
+	return current.GetUniformLocation(program, name)
+}
+
+func LinkProgram(program uint32) {
+	current.LinkProgram(program)
+}
+
+func MapBufferRange(target uint32, offset int, length int, access uint32) unsafe.Pointer {
+	return current.MapBufferRange(target, offset, length, access)
+}
+
+func MemoryBarrier(barriers uint32) {
+	current.MemoryBarrier(barriers)
+}
+
+func MultiDrawElementsIndirect(mode uint32, xtype uint32, indirect unsafe.Pointer, drawcount int32, stride int32) {
+	current.MultiDrawElementsIndirect(mode, xtype, indirect, drawcount, stride)
+}
+
+func ObjectLabel(identifier uint32, name uint32, length int32, label *uint8) {
+	current.ObjectLabel(identifier, name, length, label)
+}
+
+func PixelStorei(pname uint32, param int32) {
+	current.PixelStorei(pname, param)
+}
+
+func PolygonMode(face uint32, mode uint32) {
+	current.PolygonMode(face, mode)
+}
+
+func PopDebugGroup() {
+	current.PopDebugGroup()
+}
+
+func PushDebugGroup(source uint32, id uint32, length int32, message *uint8) {
+	current.PushDebugGroup(source, id, length, message)
+}
+
+func ReadBuffer(src uint32) {
+	current.ReadBuffer(src)
+}
+
+func ReadPixels(x int32, y int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	current.ReadPixels(x, y, width, height, format, xtype, pixels)
+}
+
+func RenderbufferStorage(target uint32, internalformat uint32, width int32, height int32) {
+	current.RenderbufferStorage(target, internalformat, width, height)
+}
+
+func Scissor(x int32, y int32, width int32, height int32) {
+	current.Scissor(x, y, width, height)
+}
+
+func ShaderSource(shader uint32, source string) {
+	current.ShaderSource(shader, source)
+}
+
+func StencilFuncSeparate(face uint32, xfunc uint32, ref int32, mask uint32) {
+	current.StencilFuncSeparate(face, xfunc, ref, mask)
+}
+
+func StencilMaskSeparate(face uint32, mask uint32) {
+	current.StencilMaskSeparate(face, mask)
+}
+
+func StencilOpSeparate(face uint32, sfail uint32, dpfail uint32, dppass uint32) {
+	current.StencilOpSeparate(face, sfail, dpfail, dppass)
+}
+
+func TexImage2D(target uint32, level int32, internalformat int32, width int32, height int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	current.TexImage2D(target, level, internalformat, width, height, border, format, xtype, pixels)
+}
+
+func TexParameteri(target uint32, pname uint32, param int32) {
+	current.TexParameteri(target, pname, param)
+}
+
+func Uniform1f(location int32, v0 float32) {
+	current.Uniform1f(location, v0)
+}
+
+func Uniform1i(location int32, v0 int32) {
+	current.Uniform1i(location, v0)
+}
+
+func Uniform2f(location int32, v0 float32, v1 float32) {
+	current.Uniform2f(location, v0, v1)
+}
+
+func Uniform3f(location int32, v0 float32, v1 float32, v2 float32) {
+	current.Uniform3f(location, v0, v1, v2)
+}
+
+func Uniform4f(location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	current.Uniform4f(location, v0, v1, v2, v3)
+}
+
+func UnmapBuffer(target uint32) bool {
+	return current.UnmapBuffer(target)
+}
+
+func UseProgram(program uint32) {
+	current.UseProgram(program)
+}
+
+func ValidateProgram(program uint32) {
+	current.ValidateProgram(program)
+}
+
+func VertexAttribDivisor(index uint32, divisor uint32) {
+	current.VertexAttribDivisor(index, divisor)
+}
+
+func VertexAttribPointerWithOffset(index uint32, size int32, xtype uint32, normalized bool, stride int32, offset uintptr) {
+	current.VertexAttribPointerWithOffset(index, size, xtype, normalized, stride, offset)
+}
+
+func Viewport(x int32, y int32, width int32, height int32) {
+	current.Viewport(x, y, width, height)
+}
+
+func Version() (major, minor int, es bool) {
+	return current.Version()
+}
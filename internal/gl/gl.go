@@ -0,0 +1,45 @@
+// Package gl mirrors the go-gl bindings API used by package shaders
+// but forwards every call to a backend.Backend set with SetBackend.
+// This way package shaders reads like regular go-gl code while being
+// independent of the OpenGL binding in use.
+package gl
+
+import (
+	"strings"
+	"unsafe"
+
+	"github.com/soypat/shaders/backend"
+)
+
+//go:generate go run ../cmd/genbackend -mode=shim -iface ../../backend/backend.go -o funcs.go
+//go:generate go run ../cmd/genbackend -mode=consts -o enums.go
+
+var current backend.Backend
+
+// SetBackend sets the backend subsequent calls are forwarded to.
+func SetBackend(b backend.Backend) { current = b }
+
+// Backend returns the backend calls are forwarded to.
+func Backend() backend.Backend { return current }
+
+// Str takes a null-terminated Go string and returns its GL-compatible address.
+// The caller must ensure the string is not garbage collected during use.
+func Str(str string) *uint8 {
+	if !strings.HasSuffix(str, "\x00") {
+		panic("str argument missing null terminator: " + str)
+	}
+	return *(**uint8)(unsafe.Pointer(&str))
+}
+
+// GoStr takes a null-terminated string returned by OpenGL and
+// constructs a corresponding Go string.
+func GoStr(cstr *uint8) string {
+	if cstr == nil {
+		return ""
+	}
+	n := 0
+	for *(*uint8)(unsafe.Add(unsafe.Pointer(cstr), n)) != 0 {
+		n++
+	}
+	return string(unsafe.Slice(cstr, n))
+}
@@ -0,0 +1,184 @@
+package isf
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"unicode"
+)
+
+// Eval evaluates the expression with the given $variables, written
+// without the dollar sign. It supports numbers, + - * /, parentheses and the
+// functions floor, ceil, round, abs, sqrt, pow, min and max.
+func (e Expr) Eval(vars map[string]float64) (float64, error) {
+	p := exprParser{src: string(e), vars: vars}
+	v, err := p.sum()
+	if err == nil {
+		p.skipSpace()
+		if p.pos < len(p.src) {
+			err = p.errorf("unexpected %q", p.src[p.pos:])
+		}
+	}
+	return v, err
+}
+
+var exprFuncs = map[string]func(args []float64) (float64, bool){
+	"floor": func(a []float64) (float64, bool) { return unary(math.Floor, a) },
+	"ceil":  func(a []float64) (float64, bool) { return unary(math.Ceil, a) },
+	"round": func(a []float64) (float64, bool) { return unary(math.Round, a) },
+	"abs":   func(a []float64) (float64, bool) { return unary(math.Abs, a) },
+	"sqrt":  func(a []float64) (float64, bool) { return unary(math.Sqrt, a) },
+	"pow":   func(a []float64) (float64, bool) { return binary(math.Pow, a) },
+	"min":   func(a []float64) (float64, bool) { return binary(math.Min, a) },
+	"max":   func(a []float64) (float64, bool) { return binary(math.Max, a) },
+}
+
+func unary(f func(float64) float64, args []float64) (float64, bool) {
+	if len(args) != 1 {
+		return 0, false
+	}
+	return f(args[0]), true
+}
+
+func binary(f func(a, b float64) float64, args []float64) (float64, bool) {
+	if len(args) != 2 {
+		return 0, false
+	}
+	return f(args[0], args[1]), true
+}
+
+// exprParser is a recursive descent parser evaluating as it parses.
+type exprParser struct {
+	src  string
+	pos  int
+	vars map[string]float64
+}
+
+func (p *exprParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("isf: expression %q: %s", p.src, fmt.Sprintf(format, args...))
+}
+
+func (p *exprParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+// consume skips spaces and the byte c if it is next.
+func (p *exprParser) consume(c byte) bool {
+	p.skipSpace()
+	if p.pos < len(p.src) && p.src[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *exprParser) sum() (float64, error) {
+	v, err := p.product()
+	for err == nil {
+		var rhs float64
+		switch {
+		case p.consume('+'):
+			rhs, err = p.product()
+			v += rhs
+		case p.consume('-'):
+			rhs, err = p.product()
+			v -= rhs
+		default:
+			return v, nil
+		}
+	}
+	return v, err
+}
+
+func (p *exprParser) product() (float64, error) {
+	v, err := p.unary()
+	for err == nil {
+		var rhs float64
+		switch {
+		case p.consume('*'):
+			rhs, err = p.unary()
+			v *= rhs
+		case p.consume('/'):
+			rhs, err = p.unary()
+			v /= rhs
+		default:
+			return v, nil
+		}
+	}
+	return v, err
+}
+
+func (p *exprParser) unary() (float64, error) {
+	if p.consume('-') {
+		v, err := p.unary()
+		return -v, err
+	}
+	p.consume('+')
+	return p.primary()
+}
+
+func (p *exprParser) primary() (float64, error) {
+	p.skipSpace()
+	if p.consume('(') {
+		v, err := p.sum()
+		if err == nil && !p.consume(')') {
+			err = p.errorf("missing )")
+		}
+		return v, err
+	}
+	if p.consume('$') {
+		name := p.identifier()
+		v, ok := p.vars[name]
+		if !ok {
+			return 0, p.errorf("unknown variable $%s", name)
+		}
+		return v, nil
+	}
+	start := p.pos
+	if name := p.identifier(); name != "" {
+		f, ok := exprFuncs[name]
+		if !ok || !p.consume('(') {
+			return 0, p.errorf("unknown function %s", name)
+		}
+		var args []float64
+		for !p.consume(')') {
+			if len(args) > 0 && !p.consume(',') {
+				return 0, p.errorf("expected , or ) in arguments of %s", name)
+			}
+			arg, err := p.sum()
+			if err != nil {
+				return 0, err
+			}
+			args = append(args, arg)
+		}
+		v, ok := f(args)
+		if !ok {
+			return 0, p.errorf("wrong number of arguments to %s", name)
+		}
+		return v, nil
+	}
+	for p.pos < len(p.src) && (p.src[p.pos] == '.' || p.src[p.pos] >= '0' && p.src[p.pos] <= '9') {
+		p.pos++
+	}
+	if start == p.pos {
+		if p.pos == len(p.src) {
+			return 0, p.errorf("unexpected end")
+		}
+		return 0, p.errorf("unexpected %q", p.src[p.pos:])
+	}
+	return strconv.ParseFloat(p.src[start:p.pos], 64)
+}
+
+func (p *exprParser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := rune(p.src[p.pos])
+		if !unicode.IsLetter(c) && c != '_' && !(p.pos > start && unicode.IsDigit(c)) {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
@@ -0,0 +1,53 @@
+package isf
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestExprEval(t *testing.T) {
+	vars := map[string]float64{"WIDTH": 640, "HEIGHT": 360, "blur_amount": 2.5}
+	tests := []struct {
+		expr Expr
+		want float64
+		// err is a substring of the expected error, empty if none is expected.
+		err string
+	}{
+		{expr: "$WIDTH/2", want: 320},
+		{expr: "max(1, floor($HEIGHT*0.25))", want: 90},
+		{expr: "max(1.0,floor($HEIGHT*0.001))", want: 1},
+		{expr: " 2 + 3 * 4 ", want: 14},
+		{expr: "(2 + 3) * 4", want: 20},
+		{expr: "-$WIDTH", want: -640},
+		{expr: "--3", want: 3},
+		{expr: "2*-3", want: -6},
+		{expr: "+4", want: 4},
+		{expr: "pow(2, 10) - sqrt(16)", want: 1020},
+		{expr: "round($blur_amount) + ceil(0.1) + abs(-1)", want: 5},
+		{expr: "min($WIDTH, $HEIGHT)", want: 360},
+		{expr: "640", want: 640},
+		{expr: "$DEPTH", err: "unknown variable $DEPTH"},
+		{expr: "log(2)", err: "unknown function log"},
+		{expr: "floor", err: "unknown function floor"},
+		{expr: "max(1)", err: "wrong number of arguments to max"},
+		{expr: "floor(1, 2)", err: "wrong number of arguments to floor"},
+		{expr: "max(1 2)", err: "expected , or )"},
+		{expr: "$WIDTH/2 px", err: `unexpected "px"`},
+		{expr: "(1 + 2", err: "missing )"},
+		{expr: "1 +", err: "unexpected end"},
+		{expr: "", err: "unexpected end"},
+	}
+	for _, test := range tests {
+		got, err := test.expr.Eval(vars)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%q: got %v, %v; want error containing %q", test.expr, got, err, test.err)
+			}
+			continue
+		}
+		if err != nil || math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("%q: got %v, %v; want %v", test.expr, got, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+// Package isf loads shaders in the Interactive Shader Format used by VJ software,
+// https://isf.video. An ISF shader is a GLSL fragment shader beginning with a
+// JSON comment which declares its inputs, render passes and imported images.
+//
+//	s, err := isf.Load("effects/Bloom.fs")
+//	if err != nil {
+//		return err
+//	}
+//	player, err := isf.NewPlayer(s, isf.Config{Width: 1280, Height: 720, Dir: "effects"})
+//	if err != nil {
+//		return err
+//	}
+//	defer player.Delete()
+//	player.SetImage("inputImage", video)
+//	player.SetInput("intensity", 0.8)
+//	err = player.Render(renderer, &uniforms)
+package isf
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Input types.
+const (
+	TypeEvent    = "event"
+	TypeBool     = "bool"
+	TypeLong     = "long"
+	TypeFloat    = "float"
+	TypePoint2D  = "point2D"
+	TypeColor    = "color"
+	TypeImage    = "image"
+	TypeAudio    = "audio"
+	TypeAudioFFT = "audioFFT"
+)
+
+// Shader is a parsed ISF shader.
+type Shader struct {
+	Version     string   `json:"ISFVSN"`
+	Description string   `json:"DESCRIPTION"`
+	Credit      string   `json:"CREDIT"`
+	Categories  []string `json:"CATEGORIES"`
+	Inputs      []Input  `json:"INPUTS"`
+	// Passes are rendered in order every frame. A shader with no passes
+	// renders a single pass to the output.
+	Passes []Pass `json:"PASSES"`
+	// Imported are images loaded from files and sampled by name.
+	Imported Imports `json:"IMPORTED"`
+	// Source is the GLSL code including the JSON comment, so that
+	// line numbers of compile errors match the file.
+	Source string `json:"-"`
+}
+
+// Input is a uniform set by the host application, i.e: a slider or an image.
+type Input struct {
+	Name string `json:"NAME"`
+	// Type is one of the Type* constants.
+	Type  string `json:"TYPE"`
+	Label string `json:"LABEL"`
+	// Default, Min, Max and Identity have 1 component for event, bool, long and float
+	// inputs, 2 for point2D and 4 for color. Booleans are 0 or 1.
+	Default  Value `json:"DEFAULT"`
+	Min      Value `json:"MIN"`
+	Max      Value `json:"MAX"`
+	Identity Value `json:"IDENTITY"`
+	// Values and Labels enumerate the choices of long inputs.
+	Values []int    `json:"VALUES"`
+	Labels []string `json:"LABELS"`
+}
+
+// Pass is a render pass. Passes with a Target render to a buffer sampled
+// by later passes, and the next frame if Persistent, with the Target name.
+type Pass struct {
+	Target     string `json:"TARGET"`
+	Persistent Bool   `json:"PERSISTENT"`
+	// Float stores the buffer with 32 bit float components instead of bytes.
+	Float Bool `json:"FLOAT"`
+	// Width and Height are expressions of the buffer size, i.e: "$WIDTH/2".
+	// Variables are $WIDTH and $HEIGHT of the output and the number inputs.
+	// Empty expressions default to the output size.
+	Width  Expr `json:"WIDTH"`
+	Height Expr `json:"HEIGHT"`
+}
+
+// Imports maps sampler names to imported images.
+type Imports map[string]Import
+
+// Import is an image file. Path is relative to the shader's directory.
+type Import struct {
+	Path string `json:"PATH"`
+	// Type is empty for 2D images or "cube" for cube maps.
+	Type string `json:"TYPE"`
+}
+
+// UnmarshalJSON accepts the ISF 2 object keyed by name and the
+// ISF 1 array of objects with a NAME.
+func (im *Imports) UnmarshalJSON(b []byte) error {
+	var byName map[string]Import
+	if err := json.Unmarshal(b, &byName); err == nil {
+		*im = byName
+		return nil
+	}
+	var list []struct {
+		Name string `json:"NAME"`
+		Import
+	}
+	if err := json.Unmarshal(b, &list); err != nil {
+		return err
+	}
+	*im = make(Imports, len(list))
+	for _, v := range list {
+		(*im)[v.Name] = v.Import
+	}
+	return nil
+}
+
+// Value is an input value, written in JSON as a number, boolean or array of numbers.
+type Value []float32
+
+// UnmarshalJSON accepts numbers, booleans and arrays of numbers.
+func (v *Value) UnmarshalJSON(b []byte) error {
+	var f float32
+	if err := json.Unmarshal(b, &f); err == nil {
+		*v = Value{f}
+		return nil
+	}
+	var bl bool
+	if err := json.Unmarshal(b, &bl); err == nil {
+		*v = Value{0}
+		if bl {
+			(*v)[0] = 1
+		}
+		return nil
+	}
+	var arr []float32
+	if err := json.Unmarshal(b, &arr); err != nil {
+		return fmt.Errorf("isf: value %s is not a number, boolean or array", b)
+	}
+	*v = arr
+	return nil
+}
+
+// Bool is a boolean, written in JSON as a boolean, number or string.
+type Bool bool
+
+// UnmarshalJSON accepts booleans, numbers and the strings "true" and "false".
+func (v *Bool) UnmarshalJSON(b []byte) error {
+	var val Value
+	if err := val.UnmarshalJSON(b); err == nil && len(val) == 1 {
+		*v = Bool(val[0] != 0)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	parsed, err := strconv.ParseBool(s)
+	*v = Bool(parsed)
+	return err
+}
+
+// Expr is an arithmetic expression, written in JSON as a string or a number.
+type Expr string
+
+// UnmarshalJSON accepts strings and numbers.
+func (e *Expr) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*e = Expr(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	*e = Expr(n.String())
+	return nil
+}
+
+// Parse reads an ISF shader and its JSON header.
+func Parse(r io.Reader) (*Shader, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	src := string(b)
+	start := strings.Index(src, "/*")
+	if start < 0 || strings.TrimSpace(src[:start]) != "" {
+		return nil, errors.New("isf: source does not begin with a JSON comment")
+	}
+	end := strings.Index(src[start:], "*/")
+	if end < 0 {
+		return nil, errors.New("isf: unterminated JSON comment")
+	}
+	header := bytes.TrimSpace([]byte(src[start+2 : start+end]))
+	s := &Shader{Source: src}
+	if err := json.Unmarshal(header, s); err != nil {
+		return nil, fmt.Errorf("isf: JSON header: %w", err)
+	}
+	for _, in := range s.Inputs {
+		if in.Name == "" {
+			return nil, errors.New("isf: input with no name")
+		}
+		if _, ok := glslTypes[in.Type]; !ok {
+			return nil, fmt.Errorf("isf: input %s has unknown type %q", in.Name, in.Type)
+		}
+	}
+	return s, nil
+}
+
+// Load reads an ISF shader from a file, usually with the .fs extension.
+func Load(name string) (*Shader, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// glslTypes are the GLSL types of the uniforms of each input type.
+var glslTypes = map[string]string{
+	TypeEvent:    "bool",
+	TypeBool:     "bool",
+	TypeLong:     "int",
+	TypeFloat:    "float",
+	TypePoint2D:  "vec2",
+	TypeColor:    "vec4",
+	TypeImage:    "sampler2D",
+	TypeAudio:    "sampler2D",
+	TypeAudioFFT: "sampler2D",
+}
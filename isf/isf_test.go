@@ -0,0 +1,120 @@
+package isf
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	const body = "\nvoid main() { gl_FragColor = vec4(TIME); }\n"
+	tests := []struct {
+		name   string
+		header string
+		err    string
+		check  func(t *testing.T, s *Shader)
+	}{
+		{
+			name: "inputs",
+			header: `{"ISFVSN": "2", "INPUTS": [
+				{"NAME": "amount", "TYPE": "float", "DEFAULT": 0.5, "MIN": 0, "MAX": 1},
+				{"NAME": "on", "TYPE": "bool", "DEFAULT": true},
+				{"NAME": "tint", "TYPE": "color", "DEFAULT": [1, 0.5, 0, 1]}
+			]}`,
+			check: func(t *testing.T, s *Shader) {
+				if s.Version != "2" || len(s.Inputs) != 3 {
+					t.Fatalf("got version %q and %d inputs", s.Version, len(s.Inputs))
+				}
+				if v := s.Inputs[0].Default; len(v) != 1 || v[0] != 0.5 {
+					t.Errorf("float default: got %v", v)
+				}
+				if v := s.Inputs[1].Default; len(v) != 1 || v[0] != 1 {
+					t.Errorf("bool default: got %v", v)
+				}
+				if v := s.Inputs[2].Default; len(v) != 4 || v[1] != 0.5 {
+					t.Errorf("color default: got %v", v)
+				}
+			},
+		},
+		{
+			name: "persistent",
+			header: `{"PASSES": [
+				{"TARGET": "a", "PERSISTENT": "true", "WIDTH": "$WIDTH/2", "HEIGHT": 64},
+				{"TARGET": "b", "PERSISTENT": 1, "FLOAT": true},
+				{"TARGET": "c", "PERSISTENT": "false", "FLOAT": 0},
+				{}
+			]}`,
+			check: func(t *testing.T, s *Shader) {
+				if len(s.Passes) != 4 {
+					t.Fatalf("got %d passes", len(s.Passes))
+				}
+				a, b, c := s.Passes[0], s.Passes[1], s.Passes[2]
+				if !a.Persistent || a.Float || a.Width != "$WIDTH/2" || a.Height != "64" {
+					t.Errorf("pass a: got %+v", a)
+				}
+				if !b.Persistent || !b.Float {
+					t.Errorf("pass b: got %+v", b)
+				}
+				if c.Persistent || c.Float {
+					t.Errorf("pass c: got %+v", c)
+				}
+			},
+		},
+		{
+			name:   "imported object",
+			header: `{"IMPORTED": {"noise": {"PATH": "noise.png"}, "sky": {"PATH": "sky.png", "TYPE": "cube"}}}`,
+			check:  checkImports,
+		},
+		{
+			name:   "imported array",
+			header: `{"IMPORTED": [{"NAME": "noise", "PATH": "noise.png"}, {"NAME": "sky", "PATH": "sky.png", "TYPE": "cube"}]}`,
+			check:  checkImports,
+		},
+		{name: "bad persistent", header: `{"PASSES": [{"PERSISTENT": "maybe"}]}`, err: "JSON header"},
+		{name: "unnamed input", header: `{"INPUTS": [{"TYPE": "float"}]}`, err: "input with no name"},
+		{name: "unknown input type", header: `{"INPUTS": [{"NAME": "x", "TYPE": "vec3"}]}`, err: `unknown type "vec3"`},
+		{name: "malformed", header: `{"INPUTS": [}`, err: "JSON header"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src := "/*" + test.header + "*/" + body
+			s, err := Parse(strings.NewReader(src))
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("got %v, want error containing %q", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.Source != src {
+				t.Error("source not kept")
+			}
+			test.check(t, s)
+		})
+	}
+}
+
+func checkImports(t *testing.T, s *Shader) {
+	if len(s.Imported) != 2 {
+		t.Fatalf("got imports %v", s.Imported)
+	}
+	if im := s.Imported["noise"]; im.Path != "noise.png" || im.Type != "" {
+		t.Errorf("noise: got %+v", im)
+	}
+	if im := s.Imported["sky"]; im.Path != "sky.png" || im.Type != "cube" {
+		t.Errorf("sky: got %+v", im)
+	}
+}
+
+func TestParseNoHeader(t *testing.T) {
+	for _, src := range []string{
+		"void main() {}",
+		"float x;\n/*{}*/",
+		"/*{\"INPUTS\": []}",
+	} {
+		if _, err := Parse(strings.NewReader(src)); err == nil {
+			t.Errorf("%q: expected error", src)
+		}
+	}
+}
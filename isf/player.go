@@ -0,0 +1,440 @@
+package isf
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/jpeg"
+	_ "image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/soypat/shaders"
+)
+
+// prelude declares the ISF built-in uniforms and functions.
+const prelude = `uniform int PASSINDEX;
+uniform vec2 RENDERSIZE;
+uniform float TIME;
+uniform float TIMEDELTA;
+uniform vec4 DATE;
+uniform int FRAMEINDEX;
+#define isf_FragNormCoord (gl_FragCoord.xy / RENDERSIZE)
+#define vv_FragNormCoord isf_FragNormCoord
+#define IMG_SIZE(image) vec2(textureSize(image, 0))
+#define IMG_NORM_PIXEL(image, coord) texture(image, coord)
+#define IMG_PIXEL(image, coord) texture(image, (coord) / IMG_SIZE(image))
+#define IMG_THIS_NORM_PIXEL(image) texture(image, isf_FragNormCoord)
+#define IMG_THIS_PIXEL(image) texture(image, isf_FragNormCoord)
+`
+
+// Config configures a Player.
+type Config struct {
+	// Width and Height of the output in pixels, the $WIDTH and $HEIGHT of pass size expressions.
+	Width, Height int
+	// Dir is the directory IMPORTED image paths are relative to,
+	// usually the directory of the shader file.
+	Dir string
+}
+
+// Player renders an ISF shader. Passes with a target render to buffers which
+// are double buffered, so a persistent pass sampling its own target reads the
+// previous frame. Passes with no target render to the current framebuffer.
+type Player struct {
+	shader *Shader
+	width  int
+	height int
+	prog   shaders.Program
+	// values of the inputs by name. Image inputs are in images.
+	values map[string]Value
+	images map[string]shaders.Texture
+	// targets holds the buffers of the passes with a TARGET by name.
+	targets map[string]*target
+	// samplers are the names of all sampler uniforms. Sampler i reads texture unit i.
+	samplers []string
+	// textures are owned by the player: imported images and the placeholder for unset images.
+	textures []shaders.Texture
+	empty    shaders.Texture
+}
+
+type target struct {
+	// formats are tried in order until the context renders to one.
+	formats []shaders.TextureFormat
+	// front holds the latest frame, back is rendered to.
+	front, back *shaders.Framebuffer
+}
+
+// NewPlayer compiles s and loads its imported images with the current OpenGL
+// context. Inputs are set to their defaults, image and audio inputs to a transparent
+// texture until set with SetImage.
+func NewPlayer(s *Shader, cfg Config) (_ *Player, err error) {
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, errors.New("isf: dimensions must be positive")
+	}
+	p := &Player{
+		shader:  s,
+		width:   cfg.Width,
+		height:  cfg.Height,
+		values:  make(map[string]Value),
+		images:  make(map[string]shaders.Texture),
+		targets: make(map[string]*target),
+	}
+	defer func() {
+		if err != nil {
+			p.Delete()
+		}
+	}()
+	p.empty, err = shaders.NewTexture(1, 1, shaders.FormatRGBA8, []byte{0, 0, 0, 0})
+	if err != nil {
+		return nil, err
+	}
+	p.textures = append(p.textures, p.empty)
+
+	var decl strings.Builder
+	decl.WriteString(prelude)
+	for _, in := range s.Inputs {
+		fmt.Fprintf(&decl, "uniform %s %s;\n", glslTypes[in.Type], in.Name)
+		if glslTypes[in.Type] == "sampler2D" {
+			p.samplers = append(p.samplers, in.Name)
+			p.images[in.Name] = p.empty
+		} else {
+			p.values[in.Name] = in.Default
+		}
+	}
+	for name, im := range s.Imported {
+		if im.Type != "" && im.Type != "2D" {
+			return nil, fmt.Errorf("isf: imported %s has unsupported type %q", name, im.Type)
+		}
+		tex, err := loadImage(filepath.Join(cfg.Dir, filepath.FromSlash(im.Path)))
+		if err != nil {
+			return nil, fmt.Errorf("isf: imported %s: %w", name, err)
+		}
+		p.textures = append(p.textures, tex)
+		p.images[name] = tex
+		p.samplers = append(p.samplers, name)
+		fmt.Fprintf(&decl, "uniform sampler2D %s;\n", name)
+	}
+	floatFormats := []shaders.TextureFormat{shaders.FormatRGBA32F, shaders.FormatRGBA16F, shaders.FormatRGBA8}
+	if caps := shaders.Capabilities(); caps.ES && !caps.HasExtension("GL_OES_texture_float_linear") {
+		// OpenGL ES can not filter 32 bit float textures by default.
+		floatFormats = floatFormats[1:]
+	}
+	for _, pass := range s.Passes {
+		if pass.Target == "" || p.targets[pass.Target] != nil {
+			continue
+		}
+		formats := []shaders.TextureFormat{shaders.FormatRGBA8}
+		if pass.Float {
+			formats = floatFormats
+		}
+		p.targets[pass.Target] = &target{formats: formats}
+		p.samplers = append(p.samplers, pass.Target)
+		fmt.Fprintf(&decl, "uniform sampler2D %s;\n", pass.Target)
+	}
+	if err := p.resizeTargets(); err != nil {
+		return nil, err
+	}
+
+	// The JSON header is a comment so the source compiles as is after the declarations.
+	src := decl.String() + "#line 1\n" + s.Source + "\x00"
+	p.prog, err = shaders.NewFragmentProgram(src)
+	if err != nil {
+		return nil, fmt.Errorf("isf: %w", err)
+	}
+	return p, nil
+}
+
+// SetInput sets the value of an event, bool, long, float, point2D or color input.
+// Booleans are 0 or 1. Events are true during the next rendered frame only.
+func (p *Player) SetInput(name string, v ...float32) error {
+	in := p.input(name)
+	if in == nil {
+		return fmt.Errorf("isf: no input named %s", name)
+	}
+	want := 1
+	switch in.Type {
+	case TypePoint2D:
+		want = 2
+	case TypeColor:
+		want = 4
+	case TypeImage, TypeAudio, TypeAudioFFT:
+		return fmt.Errorf("isf: input %s is an image, use SetImage", name)
+	}
+	if len(v) != want {
+		return fmt.Errorf("isf: input %s of type %s takes %d values, got %d", name, in.Type, want, len(v))
+	}
+	p.values[name] = append(Value(nil), v...)
+	return nil
+}
+
+// SetImage sets the texture of an image, audio or audioFFT input, i.e: the
+// "inputImage" of filters. The player does not take ownership of tex.
+func (p *Player) SetImage(name string, tex shaders.Texture) error {
+	in := p.input(name)
+	if in == nil || glslTypes[in.Type] != "sampler2D" {
+		return fmt.Errorf("isf: no image input named %s", name)
+	}
+	p.images[name] = tex
+	return nil
+}
+
+func (p *Player) input(name string) *Input {
+	for i := range p.shader.Inputs {
+		if p.shader.Inputs[i].Name == name {
+			return &p.shader.Inputs[i]
+		}
+	}
+	return nil
+}
+
+// Render draws all passes of a frame. u holds the time, frame and date of the
+// frame, its Width and Height are ignored in favor of the player's size.
+// If u is nil the frame is rendered at time zero.
+func (p *Player) Render(r *shaders.Renderer, u *shaders.StandardUniforms) error {
+	if err := p.resizeTargets(); err != nil {
+		return err
+	}
+	var uniforms shaders.StandardUniforms
+	if u != nil {
+		uniforms = *u
+	}
+	p.prog.Bind()
+	if err := p.setUniforms(&uniforms); err != nil {
+		return err
+	}
+	passes := p.shader.Passes
+	if len(passes) == 0 {
+		passes = []Pass{{}}
+	}
+	for i, pass := range passes {
+		if err := p.renderPass(r, i, pass); err != nil {
+			return fmt.Errorf("isf: pass %d: %w", i, err)
+		}
+	}
+	// Events are true for a single frame.
+	for _, in := range p.shader.Inputs {
+		if in.Type == TypeEvent {
+			p.values[in.Name] = Value{0}
+		}
+	}
+	return nil
+}
+
+func (p *Player) renderPass(r *shaders.Renderer, index int, pass Pass) error {
+	width, height := p.width, p.height
+	t := p.targets[pass.Target]
+	if t != nil {
+		width, height = t.back.Size()
+		t.back.Bind()
+		defer func() {
+			t.back.Unbind()
+			t.front, t.back = t.back, t.front
+		}()
+	}
+	if err := shaders.IgnoreNoUniform(p.prog.SetUniformName1i("PASSINDEX\x00", int32(index))); err != nil {
+		return err
+	}
+	if err := shaders.IgnoreNoUniform(p.prog.SetUniformName2f("RENDERSIZE\x00", float32(width), float32(height))); err != nil {
+		return err
+	}
+	for unit, name := range p.samplers {
+		tex := p.images[name]
+		if tt := p.targets[name]; tt != nil {
+			tex = tt.front.Color(0)
+		}
+		tex.BindUnit(unit)
+		if err := shaders.IgnoreNoUniform(p.prog.SetUniformName1i(name+"\x00", int32(unit))); err != nil {
+			return fmt.Errorf("sampler %s: %w", name, err)
+		}
+	}
+	return r.DrawFullscreen(p.prog)
+}
+
+// setUniforms sets the uniforms that are the same for all passes of a frame.
+func (p *Player) setUniforms(u *shaders.StandardUniforms) error {
+	var date [4]float32
+	if !u.Date.IsZero() {
+		d := u.Date
+		midnight := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, d.Location())
+		date = [4]float32{float32(d.Year()), float32(d.Month()), float32(d.Day()), float32(d.Sub(midnight).Seconds())}
+	}
+	prog := p.prog
+	errs := []error{
+		prog.SetUniformName1f("TIME\x00", u.Time),
+		prog.SetUniformName1f("TIMEDELTA\x00", u.Delta),
+		prog.SetUniformName1i("FRAMEINDEX\x00", int32(u.Frame)),
+		prog.SetUniformName4f("DATE\x00", date[0], date[1], date[2], date[3]),
+	}
+	for _, err := range errs {
+		if err := shaders.IgnoreNoUniform(err); err != nil {
+			return err
+		}
+	}
+	for _, in := range p.shader.Inputs {
+		v := p.values[in.Name]
+		name := in.Name + "\x00"
+		var err error
+		switch in.Type {
+		case TypeEvent, TypeBool, TypeLong:
+			var i int32
+			if len(v) > 0 {
+				i = int32(math.Round(float64(v[0])))
+			}
+			err = prog.SetUniformName1i(name, i)
+		case TypeFloat:
+			v = append(v, 0)
+			err = prog.SetUniformName1f(name, v[0])
+		case TypePoint2D:
+			v = append(v, 0, 0)
+			err = prog.SetUniformName2f(name, v[0], v[1])
+		case TypeColor:
+			v = append(v, 0, 0, 0, 0)
+			err = prog.SetUniformName4f(name, v[0], v[1], v[2], v[3])
+		}
+		if err := shaders.IgnoreNoUniform(err); err != nil {
+			return fmt.Errorf("input %s: %w", in.Name, err)
+		}
+	}
+	return nil
+}
+
+// resizeTargets creates the buffers of targets whose size expression evaluates
+// to a different size than their current one.
+func (p *Player) resizeTargets() error {
+	vars := map[string]float64{"WIDTH": float64(p.width), "HEIGHT": float64(p.height)}
+	for _, in := range p.shader.Inputs {
+		if v := p.values[in.Name]; len(v) == 1 {
+			vars[in.Name] = float64(v[0])
+		}
+	}
+	for _, pass := range p.shader.Passes {
+		t := p.targets[pass.Target]
+		if t == nil {
+			continue
+		}
+		width, err := evalSize(pass.Width, vars, p.width)
+		if err != nil {
+			return err
+		}
+		height, err := evalSize(pass.Height, vars, p.height)
+		if err != nil {
+			return err
+		}
+		if t.front != nil {
+			if w, h := t.front.Size(); w == width && h == height {
+				continue
+			}
+			t.front.Delete()
+			t.back.Delete()
+			t.front, t.back = nil, nil
+		}
+		if err := t.create(width, height); err != nil {
+			return fmt.Errorf("isf: target %s: %w", pass.Target, err)
+		}
+	}
+	return nil
+}
+
+// create creates the buffers of t with the first format the context renders to.
+// Buffers start out transparent.
+func (t *target) create(width, height int) (err error) {
+	for i, format := range t.formats {
+		cfg := shaders.FramebufferConfig{Width: width, Height: height, Color: []shaders.TextureFormat{format}}
+		t.front, err = shaders.NewFramebuffer(cfg)
+		if err == nil {
+			t.back, err = shaders.NewFramebuffer(cfg)
+			if err != nil {
+				t.front.Delete()
+				t.front = nil
+			}
+		}
+		if err == nil {
+			t.formats = t.formats[i : i+1]
+			break
+		}
+		shaders.ClearErrors()
+	}
+	if err != nil {
+		return err
+	}
+	for _, fb := range []*shaders.Framebuffer{t.front, t.back} {
+		if err := fb.Clear(color.Transparent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// evalSize evaluates a size expression, returning def if it is empty.
+func evalSize(e Expr, vars map[string]float64, def int) (int, error) {
+	if e == "" {
+		return def, nil
+	}
+	v, err := e.Eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	if v < 1 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("isf: expression %q gives invalid size %v", e, v)
+	}
+	return int(v), nil
+}
+
+// Size returns the size of the output in pixels.
+func (p *Player) Size() (width, height int) { return p.width, p.height }
+
+// Target returns the latest frame of the target buffer with the given name or nil if there is none.
+func (p *Player) Target(name string) *shaders.Framebuffer {
+	if t := p.targets[name]; t != nil {
+		return t.front
+	}
+	return nil
+}
+
+// Delete deletes the program, target buffers and imported images of the player.
+func (p *Player) Delete() {
+	p.prog.Delete()
+	for _, t := range p.targets {
+		if t.front != nil {
+			t.front.Delete()
+			t.back.Delete()
+		}
+	}
+	for _, tex := range p.textures {
+		tex.Delete()
+	}
+	*p = Player{}
+}
+
+// loadImage loads an image file into a texture with its first row at the top,
+// as ISF samples images.
+func loadImage(name string) (shaders.Texture, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return shaders.Texture{}, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return shaders.Texture{}, err
+	}
+	bounds := img.Bounds()
+	nrgba := image.NewNRGBA(image.Rectangle{Max: bounds.Size()})
+	draw.Draw(nrgba, nrgba.Rect, img, bounds.Min, draw.Src)
+	// OpenGL stores the first row at the bottom.
+	h := nrgba.Rect.Dy()
+	row := make([]byte, nrgba.Stride)
+	for y := 0; y < h/2; y++ {
+		top := nrgba.Pix[y*nrgba.Stride : (y+1)*nrgba.Stride]
+		bottom := nrgba.Pix[(h-1-y)*nrgba.Stride : (h-y)*nrgba.Stride]
+		copy(row, top)
+		copy(top, bottom)
+		copy(bottom, row)
+	}
+	return shaders.NewTexture(bounds.Dx(), bounds.Dy(), shaders.FormatRGBA8, nrgba.Pix)
+}
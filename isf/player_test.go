@@ -0,0 +1,150 @@
+package isf
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/soypat/shaders"
+	"github.com/soypat/shaders/backend/fake"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// colorTarget returns the texture the draw rendered to.
+func colorTarget(b *fake.Backend, d fake.Draw) uint32 {
+	return b.Framebuffer(d.Framebuffer).Attachments[gl.COLOR_ATTACHMENT0].Texture
+}
+
+func TestPlayerRender(t *testing.T) {
+	b := fake.New(16, 16)
+	shaders.SetBackend(b)
+	s, err := Parse(strings.NewReader(`/*{
+	"INPUTS": [
+		{"NAME": "inputImage", "TYPE": "image"},
+		{"NAME": "reset", "TYPE": "event"},
+		{"NAME": "divisor", "TYPE": "long", "DEFAULT": 3}
+	],
+	"PASSES": [
+		{"TARGET": "trail", "PERSISTENT": true, "FLOAT": true, "WIDTH": "$WIDTH/2"},
+		{"TARGET": "blurred", "WIDTH": "$WIDTH/2", "HEIGHT": "$HEIGHT/$divisor"},
+		{}
+	]
+}*/
+void main() {
+	if (PASSINDEX == 0) {
+		gl_FragColor = reset ? IMG_THIS_PIXEL(inputImage) : IMG_THIS_PIXEL(trail);
+	} else if (PASSINDEX == 1) {
+		gl_FragColor = IMG_THIS_PIXEL(trail);
+	} else {
+		gl_FragColor = IMG_THIS_PIXEL(blurred) + IMG_THIS_PIXEL(inputImage);
+	}
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewPlayer(s, Config{Width: 8, Height: 6})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Delete()
+	img, err := shaders.NewTexture(3, 5, shaders.FormatRGBA8, make([]byte, 4*3*5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer img.Delete()
+	if err := p.SetImage("inputImage", img); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetInput("reset", 1); err != nil {
+		t.Fatal(err)
+	}
+	r := shaders.NewRenderer()
+	render := func() []fake.Draw {
+		t.Helper()
+		n := len(b.Draws)
+		if err := p.Render(r, nil); err != nil {
+			t.Fatal(err)
+		}
+		if len(b.Draws)-n != 3 {
+			t.Fatalf("got %d draws, want one per pass", len(b.Draws)-n)
+		}
+		return b.Draws[n:]
+	}
+	// Samplers read texture units in the order of inputs and then of targets.
+	const (
+		unitImage = iota
+		unitTrail
+		unitBlurred
+	)
+	reset := func(d fake.Draw) float32 { return b.Program(d.Program).Uniform("reset")[0] }
+
+	frame1 := render()
+	trail1, blurred1 := colorTarget(b, frame1[0]), colorTarget(b, frame1[1])
+	sizes := []struct {
+		d    fake.Draw
+		want [4]int32
+	}{
+		{frame1[0], [4]int32{0, 0, 4, 6}},
+		{frame1[1], [4]int32{0, 0, 4, 2}},
+		// Passes with no target render to the current framebuffer.
+		{frame1[2], [4]int32{0, 0, 16, 16}},
+	}
+	for i, size := range sizes {
+		if size.d.Viewport != size.want {
+			t.Errorf("pass %d: got viewport %v, want %v", i, size.d.Viewport, size.want)
+		}
+	}
+	if frame1[2].Framebuffer != 0 {
+		t.Errorf("last pass rendered to framebuffer %d", frame1[2].Framebuffer)
+	}
+	if tex := b.Texture(frame1[0].Textures[unitImage]); tex.Width != 3 || tex.Height != 5 {
+		t.Errorf("inputImage: got %dx%d texture, want the 3x5 image set", tex.Width, tex.Height)
+	}
+	// Passes read the front buffer of their own target, cleared on the first frame.
+	if got := frame1[0].Textures[unitTrail]; got == trail1 {
+		t.Error("trail pass read the buffer it renders to")
+	}
+	if got := frame1[1].Textures[unitTrail]; got != trail1 {
+		t.Errorf("blurred pass read trail texture %d, want this frame's %d", got, trail1)
+	}
+	if got := frame1[2].Textures; got[unitTrail] != trail1 || got[unitBlurred] != blurred1 {
+		t.Errorf("image pass read targets %d and %d, want %d and %d", got[unitTrail], got[unitBlurred], trail1, blurred1)
+	}
+	if reset(frame1[0]) != 1 {
+		t.Error("event not set during the frame after SetInput")
+	}
+
+	frame2 := render()
+	trail2, blurred2 := colorTarget(b, frame2[0]), colorTarget(b, frame2[1])
+	if trail2 == trail1 || blurred2 == blurred1 {
+		t.Error("targets rendered to the same buffers two frames in a row")
+	}
+	// The persistent pass reads its previous frame.
+	if got := frame2[0].Textures[unitTrail]; got != trail1 {
+		t.Errorf("trail pass read %d, want previous frame %d", got, trail1)
+	}
+	if got := frame2[1].Textures[unitTrail]; got != trail2 {
+		t.Errorf("blurred pass read trail texture %d, want this frame's %d", got, trail2)
+	}
+	if got := frame2[2].Textures; got[unitTrail] != trail2 || got[unitBlurred] != blurred2 {
+		t.Errorf("image pass read targets %d and %d, want %d and %d", got[unitTrail], got[unitBlurred], trail2, blurred2)
+	}
+	if reset(frame2[0]) != 0 {
+		t.Error("event still set one frame after SetInput")
+	}
+
+	// Size expressions are evaluated with the input values every frame.
+	if err := p.SetInput("divisor", 2); err != nil {
+		t.Fatal(err)
+	}
+	frame3 := render()
+	if want := [4]int32{0, 0, 4, 3}; frame3[1].Viewport != want {
+		t.Errorf("got viewport %v after changing the divisor, want %v", frame3[1].Viewport, want)
+	}
+	if w, h := p.Target("blurred").Size(); w != 4 || h != 3 {
+		t.Errorf("got blurred target of %dx%d, want 4x3", w, h)
+	}
+	if got := frame3[0].Textures[unitTrail]; got != trail2 {
+		t.Errorf("trail pass read %d, want previous frame %d", got, trail2)
+	}
+}
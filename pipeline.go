@@ -0,0 +1,306 @@
+package shaders
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"strings"
+)
+
+// PipelinePass is a render pass of a Pipeline. The program is drawn with
+// Renderer.DrawFullscreen so it is usually created with NewFragmentProgram.
+type PipelinePass struct {
+	// Name identifies the pass output to the inputs of other passes.
+	Name    string
+	Program Program
+	Inputs  []PipelineInput
+	// Format is the format of the output texture. The zero value is FormatRGBA8.
+	Format TextureFormat
+	// Scale is the size of the output relative to the pipeline size,
+	// i.e: 0.5 renders at half the resolution. The zero value is 1.
+	Scale float32
+	// Screen renders the pass to the framebuffer bound when Pipeline.Render
+	// is called instead of to an output texture. Screen passes can not be inputs.
+	Screen bool
+}
+
+// PipelineInput is a texture sampled by a pass: the output of a pass or an image.
+type PipelineInput struct {
+	// Uniform is the sampler identifier in the shader source code finished
+	// with a null terminator, i.e: "u_scene\x00".
+	Uniform string
+	// Pass is the name of the pass whose output is sampled. It must be a
+	// previous pass unless Previous is set.
+	Pass string
+	// Previous samples the output of Pass from the previous frame instead of
+	// the current one. It is how a pass reads its own output for feedback effects.
+	Previous bool
+	// Texture is sampled if Pass is empty. The pipeline does not own it.
+	Texture Texture
+}
+
+// Pipeline renders a sequence of passes each frame, each pass sampling the
+// outputs of previous passes, images and the previous frame. It allocates the
+// framebuffers of the pass outputs and double buffers outputs read with
+// PipelineInput.Previous.
+//
+//	pipeline, err := shaders.NewPipeline(width, height,
+//		shaders.PipelinePass{Name: "scene", Program: scene, Format: shaders.FormatRGBA16F},
+//		shaders.PipelinePass{Name: "trail", Program: trail, Inputs: []shaders.PipelineInput{
+//			{Uniform: "u_scene\x00", Pass: "scene"},
+//			{Uniform: "u_trail\x00", Pass: "trail", Previous: true},
+//		}},
+//		shaders.PipelinePass{Program: present, Screen: true, Inputs: []shaders.PipelineInput{
+//			{Uniform: "u_image\x00", Pass: "trail"},
+//		}},
+//	)
+type Pipeline struct {
+	width, height int
+	passes        []PipelinePass
+	// outputs holds the output of each pass, nil for screen passes.
+	outputs []*pipelineOutput
+}
+
+type pipelineOutput struct {
+	// doubled is set for outputs read with Previous. They have a back
+	// framebuffer which is rendered to, else front is rendered to.
+	doubled bool
+	// front holds the latest frame.
+	front, back *Framebuffer
+}
+
+// target returns the framebuffer the pass renders to.
+func (o *pipelineOutput) target() *Framebuffer {
+	if o.doubled {
+		return o.back
+	}
+	return o.front
+}
+
+// NewPipeline checks the passes and creates their outputs for a width by height screen
+// with the current OpenGL context. The pipeline does not own the pass programs.
+func NewPipeline(width, height int, passes ...PipelinePass) (*Pipeline, error) {
+	index := make(map[string]int)
+	for i, pass := range passes {
+		if pass.Program.rid == 0 {
+			return nil, fmt.Errorf("pipeline pass %d has no program", i)
+		}
+		if pass.Scale < 0 {
+			return nil, fmt.Errorf("pipeline pass %d has negative scale", i)
+		}
+		if pass.Screen || pass.Name == "" {
+			continue
+		}
+		if _, ok := index[pass.Name]; ok {
+			return nil, fmt.Errorf("pipeline pass %d: duplicate name %q", i, pass.Name)
+		}
+		index[pass.Name] = i
+	}
+	p := &Pipeline{passes: passes, outputs: make([]*pipelineOutput, len(passes))}
+	for i, pass := range passes {
+		if !pass.Screen {
+			p.outputs[i] = &pipelineOutput{}
+		}
+	}
+	for i, pass := range passes {
+		for _, in := range pass.Inputs {
+			if !strings.HasSuffix(in.Uniform, "\x00") {
+				return nil, fmt.Errorf("pipeline pass %d input %q: %w", i, in.Uniform, ErrStringNotNullTerminated)
+			}
+			uniform := strings.TrimSuffix(in.Uniform, "\x00")
+			if in.Pass == "" {
+				if in.Texture.rid == 0 {
+					return nil, fmt.Errorf("pipeline pass %d input %s has no pass or texture", i, uniform)
+				}
+				continue
+			}
+			j, ok := index[in.Pass]
+			switch {
+			case !ok:
+				return nil, fmt.Errorf("pipeline pass %d input %s reads unknown pass %q", i, uniform, in.Pass)
+			case in.Previous:
+				p.outputs[j].doubled = true
+			case j >= i:
+				return nil, fmt.Errorf("pipeline pass %d input %s reads pass %q before it is rendered, set Previous to read its previous frame", i, uniform, in.Pass)
+			}
+		}
+	}
+	if err := p.Resize(width, height); err != nil {
+		p.Delete()
+		return nil, err
+	}
+	return p, nil
+}
+
+// Resize recreates the pass outputs for a width by height screen if the size changed,
+// i.e: when the window is resized. Outputs start out transparent black, so
+// feedback passes restart.
+func (p *Pipeline) Resize(width, height int) (err error) {
+	if width <= 0 || height <= 0 {
+		return errors.New("pipeline dimensions must be positive")
+	}
+	if width == p.width && height == p.height {
+		return nil
+	}
+	p.width, p.height = width, height
+	defer func() {
+		if err != nil {
+			// Recreate all outputs on the next call.
+			p.width, p.height = 0, 0
+		}
+	}()
+	for i, out := range p.outputs {
+		if out == nil {
+			continue
+		}
+		pass := p.passes[i]
+		out.delete()
+		w, h := p.passSize(pass)
+		cfg := FramebufferConfig{Width: w, Height: h, Color: []TextureFormat{pass.Format}}
+		if pass.Format == (TextureFormat{}) {
+			cfg.Color[0] = FormatRGBA8
+		}
+		fbs := []**Framebuffer{&out.front}
+		if out.doubled {
+			fbs = append(fbs, &out.back)
+		}
+		for _, fb := range fbs {
+			if *fb, err = NewFramebuffer(cfg); err != nil {
+				return fmt.Errorf("pipeline pass %d output: %w", i, err)
+			}
+			if err = (*fb).Clear(color.Transparent); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// passSize returns the size of the pass output, at least 1 by 1 pixels.
+func (p *Pipeline) passSize(pass PipelinePass) (width, height int) {
+	if pass.Scale == 0 || pass.Screen {
+		return p.width, p.height
+	}
+	width = int(float32(p.width)*pass.Scale + 0.5)
+	height = int(float32(p.height)*pass.Scale + 0.5)
+	if width < 1 {
+		width = 1
+	}
+	if height < 1 {
+		height = 1
+	}
+	return width, height
+}
+
+// Render draws the passes of a frame in order. Each pass program is bound,
+// its input samplers are set to texture units 0, 1, 2... in the order of
+// Inputs and, if u is not nil, the standard uniforms are applied with the
+// resolution set to the size of the pass output. Screen passes render to the
+// framebuffer bound when Render is called with the current viewport.
+func (p *Pipeline) Render(r *Renderer, u *StandardUniforms) error {
+	var uniforms StandardUniforms
+	if u != nil {
+		uniforms = *u
+	}
+	for i, pass := range p.passes {
+		out := p.outputs[i]
+		var target *Framebuffer
+		if out != nil {
+			target = out.target()
+			target.Bind()
+		}
+		err := p.draw(r, i, u != nil, &uniforms)
+		if target != nil {
+			target.Unbind()
+			if out.doubled {
+				out.front, out.back = out.back, out.front
+			}
+		}
+		if err != nil {
+			if pass.Name != "" {
+				return fmt.Errorf("pipeline pass %q: %w", pass.Name, err)
+			}
+			return fmt.Errorf("pipeline pass %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (p *Pipeline) draw(r *Renderer, i int, apply bool, u *StandardUniforms) error {
+	pass := p.passes[i]
+	pass.Program.Bind()
+	if apply {
+		if out := p.outputs[i]; out != nil {
+			w, h := out.front.Size()
+			u.Width, u.Height = float32(w), float32(h)
+		} else {
+			_, _, w, h := r.Viewport()
+			u.Width, u.Height = float32(w), float32(h)
+		}
+		if err := u.Apply(pass.Program); err != nil {
+			return err
+		}
+	}
+	for unit, in := range pass.Inputs {
+		tex := in.Texture
+		if in.Pass != "" {
+			tex = p.input(i, in)
+		}
+		tex.BindUnit(unit)
+		if err := IgnoreNoUniform(pass.Program.SetUniformName1i(in.Uniform, int32(unit))); err != nil {
+			return err
+		}
+	}
+	return r.DrawFullscreen(pass.Program)
+}
+
+// input returns the texture pass i reads for an input of another pass's output.
+func (p *Pipeline) input(i int, in PipelineInput) Texture {
+	for j, pass := range p.passes {
+		if pass.Screen || pass.Name != in.Pass {
+			continue
+		}
+		out := p.outputs[j]
+		if in.Previous && j < i {
+			// Pass j was rendered this frame, the previous frame was swapped to the back.
+			return out.back.Color(0)
+		}
+		return out.front.Color(0)
+	}
+	return Texture{}
+}
+
+// Output returns the latest frame of the output of the named pass.
+// It returns the zero Texture if there is no such pass.
+func (p *Pipeline) Output(name string) Texture {
+	for i, pass := range p.passes {
+		if !pass.Screen && pass.Name == name {
+			return p.outputs[i].front.Color(0)
+		}
+	}
+	return Texture{}
+}
+
+// Size returns the screen size of the pipeline in pixels.
+func (p *Pipeline) Size() (width, height int) { return p.width, p.height }
+
+// Delete deletes the framebuffers of the pass outputs. The programs and
+// input textures are not deleted.
+func (p *Pipeline) Delete() {
+	for _, out := range p.outputs {
+		if out != nil {
+			out.delete()
+		}
+	}
+	*p = Pipeline{}
+}
+
+func (o *pipelineOutput) delete() {
+	if o.front != nil {
+		o.front.Delete()
+	}
+	if o.back != nil {
+		o.back.Delete()
+	}
+	o.front, o.back = nil, nil
+}
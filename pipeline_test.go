@@ -0,0 +1,166 @@
+package shaders
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/soypat/shaders/backend/fake"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// samplerProgram creates a fragment program sampling the named textures.
+func samplerProgram(t *testing.T, samplers ...string) Program {
+	t.Helper()
+	var src strings.Builder
+	src.WriteString("#version 330\nout vec4 c;\n")
+	for _, s := range samplers {
+		fmt.Fprintf(&src, "uniform sampler2D %s;\n", s)
+	}
+	src.WriteString("void main() { c = vec4(0.0); }\n\x00")
+	prog, err := NewFragmentProgram(src.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return prog
+}
+
+// colorTarget returns the texture a draw rendered to, 0 for the default framebuffer.
+func colorTarget(b *fake.Backend, d fake.Draw) uint32 {
+	if d.Framebuffer == 0 {
+		return 0
+	}
+	return b.Framebuffer(d.Framebuffer).Attachments[gl.COLOR_ATTACHMENT0].Texture
+}
+
+func TestPipelineRender(t *testing.T) {
+	b := newFake(t)
+	img, err := NewTexture(2, 2, FormatRGBA8, make([]byte, 16))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer img.Delete()
+	scene := samplerProgram(t, "u_image")
+	defer scene.Delete()
+	trail := samplerProgram(t, "u_scene", "u_trail")
+	defer trail.Delete()
+	blur := samplerProgram(t, "u_trail")
+	defer blur.Delete()
+	present := samplerProgram(t, "u_blur", "u_last")
+	defer present.Delete()
+	programs := []Program{scene, trail, blur, present}
+
+	p, err := NewPipeline(40, 20,
+		PipelinePass{Name: "scene", Program: scene, Scale: 0.5, Inputs: []PipelineInput{
+			{Uniform: "u_image\x00", Texture: img},
+		}},
+		PipelinePass{Name: "trail", Program: trail, Inputs: []PipelineInput{
+			{Uniform: "u_scene\x00", Pass: "scene"},
+			{Uniform: "u_trail\x00", Pass: "trail", Previous: true},
+		}},
+		PipelinePass{Name: "blur", Program: blur, Scale: 0.25, Inputs: []PipelineInput{
+			{Uniform: "u_trail\x00", Pass: "trail"},
+		}},
+		PipelinePass{Program: present, Screen: true, Inputs: []PipelineInput{
+			{Uniform: "u_blur\x00", Pass: "blur"},
+			{Uniform: "u_last\x00", Pass: "trail", Previous: true},
+		}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Delete()
+	r := NewRenderer()
+
+	// render renders a frame and returns its draws after checking they are
+	// of the pass programs in order.
+	render := func() []fake.Draw {
+		t.Helper()
+		n := len(b.Draws)
+		if err := p.Render(r, nil); err != nil {
+			t.Fatal(err)
+		}
+		draws := b.Draws[n:]
+		if len(draws) != len(programs) {
+			t.Fatalf("got %d draws, want %d", len(draws), len(programs))
+		}
+		for i, d := range draws {
+			if d.Program != programs[i].rid {
+				t.Fatalf("draw %d: got program %d, want %d", i, d.Program, programs[i].rid)
+			}
+		}
+		return draws
+	}
+	checkFrames := func(width, height int) {
+		t.Helper()
+		var prevTrail uint32
+		for frame := 0; frame < 3; frame++ {
+			d := render()
+			sceneTex, trailTex, blurTex := colorTarget(b, d[0]), colorTarget(b, d[1]), colorTarget(b, d[2])
+			if d[0].Textures[0] != img.rid {
+				t.Errorf("frame %d: scene sampled %d, want image %d", frame, d[0].Textures[0], img.rid)
+			}
+			if d[1].Textures[0] != sceneTex {
+				t.Errorf("frame %d: trail sampled scene %d, want this frame's %d", frame, d[1].Textures[0], sceneTex)
+			}
+			if trailTex == d[1].Textures[1] {
+				t.Errorf("frame %d: trail samples the texture %d it renders to", frame, trailTex)
+			}
+			if frame > 0 && d[1].Textures[1] != prevTrail {
+				t.Errorf("frame %d: trail sampled itself %d, want previous frame %d", frame, d[1].Textures[1], prevTrail)
+			}
+			if d[2].Textures[0] != trailTex {
+				t.Errorf("frame %d: blur sampled trail %d, want this frame's %d", frame, d[2].Textures[0], trailTex)
+			}
+			if d[3].Textures[0] != blurTex {
+				t.Errorf("frame %d: screen sampled blur %d, want %d", frame, d[3].Textures[0], blurTex)
+			}
+			if d[3].Textures[1] != d[1].Textures[1] {
+				t.Errorf("frame %d: screen sampled previous trail %d, want %d", frame, d[3].Textures[1], d[1].Textures[1])
+			}
+			if got := p.Output("trail").rid; got != trailTex {
+				t.Errorf("frame %d: trail output is %d, want latest frame %d", frame, got, trailTex)
+			}
+			prevTrail = trailTex
+
+			wantViewports := [][4]int32{
+				{0, 0, int32(width+1) / 2, int32(height+1) / 2},
+				{0, 0, int32(width), int32(height)},
+				{0, 0, int32(width+2) / 4, int32(height+2) / 4},
+				{0, 0, 16, 16},
+			}
+			for i, want := range wantViewports {
+				if d[i].Viewport != want {
+					t.Errorf("frame %d pass %d: got viewport %v, want %v", frame, i, d[i].Viewport, want)
+				}
+			}
+			if d[3].Framebuffer != 0 {
+				t.Errorf("frame %d: screen pass rendered to framebuffer %d", frame, d[3].Framebuffer)
+			}
+		}
+	}
+	checkFrames(40, 20)
+	before := p.Output("scene").rid
+	objects := b.Objects()
+	if err := p.Resize(40, 20); err != nil {
+		t.Fatal(err)
+	}
+	if p.Output("scene").rid != before {
+		t.Error("resizing to the same size recreated outputs")
+	}
+	if err := p.Resize(82, 42); err != nil {
+		t.Fatal(err)
+	}
+	after := p.Output("scene")
+	if after.rid == before {
+		t.Error("resizing did not recreate outputs")
+	}
+	if tex := b.Texture(after.rid); tex.Width != 41 || tex.Height != 21 {
+		t.Errorf("got scene output of %dx%d, want 41x21", tex.Width, tex.Height)
+	}
+	if b.Objects() != objects {
+		t.Errorf("resizing changed the object count from %d to %d", objects, b.Objects())
+	}
+	checkFrames(82, 42)
+	checkNoGLErrors(t)
+}
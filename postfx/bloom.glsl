@@ -0,0 +1,26 @@
+// Bloom extracts the colors brighter than a threshold, which are blurred by
+// blur.glsl, and adds the blurred colors back to the image.
+#shader fragment
+#version 330
+
+in vec2 v_uv;
+out vec4 fragColor;
+
+// u_stage is 0 to extract bright colors and 1 to combine.
+uniform int u_stage;
+uniform sampler2D u_image;
+uniform sampler2D u_bloom;
+uniform float u_threshold;
+uniform float u_intensity;
+
+void main() {
+	vec4 color = texture(u_image, v_uv);
+	if (u_stage == 0) {
+		float brightness = max(color.r, max(color.g, color.b));
+		// Scale instead of clipping so colors keep their hue.
+		float contribution = max(brightness - u_threshold, 0.0) / max(brightness, 0.0001);
+		fragColor = vec4(color.rgb*contribution, 1.0);
+		return;
+	}
+	fragColor = vec4(color.rgb + u_intensity*texture(u_bloom, v_uv).rgb, color.a);
+}
@@ -0,0 +1,33 @@
+// One dimensional gaussian blur along u_direction. A blur is rendered by
+// two passes, horizontal and vertical, since the gaussian is separable.
+#shader fragment
+#version 330
+
+in vec2 v_uv;
+out vec4 fragColor;
+
+uniform sampler2D u_image;
+// u_direction is (1,0) for the horizontal pass and (0,1) for the vertical one.
+uniform vec2 u_direction;
+// u_sigma is the standard deviation in pixels of the input.
+uniform float u_sigma;
+
+const int maxRadius = 64;
+
+void main() {
+	vec2 stride = u_direction / vec2(textureSize(u_image, 0));
+	float sigma = max(u_sigma, 0.001);
+	int radius = min(int(ceil(3.0*sigma)), maxRadius);
+	vec4 sum = texture(u_image, v_uv);
+	float total = 1.0;
+	for (int i = 1; i <= maxRadius; i++) {
+		if (i > radius) {
+			break;
+		}
+		float x = float(i);
+		float w = exp(-0.5*x*x/(sigma*sigma));
+		sum += w*(texture(u_image, v_uv + x*stride) + texture(u_image, v_uv - x*stride));
+		total += 2.0*w;
+	}
+	fragColor = sum / total;
+}
@@ -0,0 +1,12 @@
+// Copies the input unchanged.
+#shader fragment
+#version 330
+
+in vec2 v_uv;
+out vec4 fragColor;
+
+uniform sampler2D u_image;
+
+void main() {
+	fragColor = texture(u_image, v_uv);
+}
@@ -0,0 +1,376 @@
+package postfx
+
+import (
+	"errors"
+	"fmt"
+	"image"
+
+	"github.com/soypat/shaders"
+)
+
+// Blur is a gaussian blur rendered as a horizontal and a vertical pass.
+type Blur struct {
+	// Sigma is the standard deviation in pixels of the input. The zero value is 2.
+	Sigma float32
+	// Scale is the resolution of the blur relative to the scene, i.e: 0.5
+	// blurs at half the resolution which is faster and blurs wider. The zero value is 1.
+	Scale float32
+	// progs are the horizontal and vertical pass programs.
+	progs [2]shaders.Program
+}
+
+func (e *Blur) build(b *builder) (err error) {
+	if e.progs[0] == (shaders.Program{}) {
+		e.progs, err = newBlur()
+		if err != nil {
+			return err
+		}
+	}
+	in := b.output()
+	horizontal := b.add(e.progs[0], e.Scale, b.read("u_image\x00", in))
+	b.add(e.progs[1], e.Scale, b.read("u_image\x00", horizontal))
+	return nil
+}
+
+func (e *Blur) setUniforms() error {
+	return setBlurSigma(e.progs, e.Sigma)
+}
+
+func (e *Blur) delete() {
+	e.progs[0].Delete()
+	e.progs[1].Delete()
+	e.progs = [2]shaders.Program{}
+}
+
+// newBlur compiles blur.glsl for the horizontal and vertical passes.
+func newBlur() (progs [2]shaders.Program, err error) {
+	for i, dir := range [2][2]float32{{1, 0}, {0, 1}} {
+		progs[i], err = compile("blur.glsl")
+		if err == nil {
+			progs[i].Bind()
+			err = shaders.IgnoreNoUniform(progs[i].SetUniformName2f("u_direction\x00", dir[0], dir[1]))
+		}
+		if err != nil {
+			progs[0].Delete()
+			progs[1].Delete()
+			return [2]shaders.Program{}, err
+		}
+	}
+	return progs, nil
+}
+
+func setBlurSigma(progs [2]shaders.Program, sigma float32) error {
+	for _, prog := range progs {
+		prog.Bind()
+		if err := shaders.IgnoreNoUniform(prog.SetUniformName1f("u_sigma\x00", orDefault(sigma, 2))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Bloom makes bright colors glow by adding a blurred copy of the colors
+// brighter than a threshold to the image. It is usually followed by Tonemap.
+type Bloom struct {
+	// Threshold is the brightness, the maximum component of a color, above
+	// which colors bloom. The zero value is 1, blooming colors out of display range.
+	Threshold float32
+	// Intensity scales the glow added to the image. The zero value is 1.
+	Intensity float32
+	// Sigma is the standard deviation of the blur in pixels of the bloom
+	// resolution. The zero value is 4.
+	Sigma float32
+	// Scale is the resolution of the glow relative to the scene. The zero value is 0.5.
+	Scale float32
+	// extract and combine are the stages of bloom.glsl, blur blurs the extracted colors.
+	extract, combine shaders.Program
+	blur             [2]shaders.Program
+}
+
+func (e *Bloom) build(b *builder) (err error) {
+	if e.extract == (shaders.Program{}) {
+		if err = e.compile(); err != nil {
+			e.delete()
+			return err
+		}
+	}
+	in := b.output()
+	scale := orDefault(e.Scale, 0.5)
+	bright := b.add(e.extract, scale, b.read("u_image\x00", in))
+	horizontal := b.add(e.blur[0], scale, b.read("u_image\x00", bright))
+	blurred := b.add(e.blur[1], scale, b.read("u_image\x00", horizontal))
+	b.add(e.combine, 1, b.read("u_image\x00", in), b.read("u_bloom\x00", blurred))
+	return nil
+}
+
+func (e *Bloom) compile() (err error) {
+	for stage, prog := range []*shaders.Program{&e.extract, &e.combine} {
+		*prog, err = compile("bloom.glsl")
+		if err != nil {
+			return err
+		}
+		prog.Bind()
+		if err := shaders.IgnoreNoUniform(prog.SetUniformName1i("u_stage\x00", int32(stage))); err != nil {
+			return err
+		}
+	}
+	e.blur, err = newBlur()
+	return err
+}
+
+func (e *Bloom) setUniforms() error {
+	e.extract.Bind()
+	if err := shaders.IgnoreNoUniform(e.extract.SetUniformName1f("u_threshold\x00", orDefault(e.Threshold, 1))); err != nil {
+		return err
+	}
+	e.combine.Bind()
+	if err := shaders.IgnoreNoUniform(e.combine.SetUniformName1f("u_intensity\x00", orDefault(e.Intensity, 1))); err != nil {
+		return err
+	}
+	return setBlurSigma(e.blur, orDefault(e.Sigma, 4))
+}
+
+func (e *Bloom) delete() {
+	e.extract.Delete()
+	e.combine.Delete()
+	e.blur[0].Delete()
+	e.blur[1].Delete()
+	e.extract, e.combine = shaders.Program{}, shaders.Program{}
+	e.blur = [2]shaders.Program{}
+}
+
+// FXAA smooths jagged edges with fast approximate anti-aliasing. It expects
+// colors in display range so it usually comes after Tonemap.
+type FXAA struct {
+	prog shaders.Program
+}
+
+func (e *FXAA) build(b *builder) error {
+	return buildSingle(b, &e.prog, "fxaa.glsl")
+}
+
+func (e *FXAA) setUniforms() error { return nil }
+
+func (e *FXAA) delete() {
+	e.prog.Delete()
+	e.prog = shaders.Program{}
+}
+
+// ColorGrade remaps colors with a lookup table, i.e: one exported from a
+// photo editor by grading IdentityLUT. Colors are clamped to display range so
+// it usually comes after Tonemap.
+type ColorGrade struct {
+	// LUT is a size*size by size texture: blue selects one of size squares laid
+	// out left to right, red is the column within a square and green the row,
+	// with green 0 in the first row of texture data. The LUT should be linearly
+	// filtered. The chain does not own it.
+	LUT shaders.Texture
+	// Intensity mixes between the input, 0, and the graded colors, 1. The zero value is 1.
+	Intensity float32
+	prog      shaders.Program
+}
+
+func (e *ColorGrade) build(b *builder) error {
+	if e.LUT == (shaders.Texture{}) {
+		return errors.New("postfx: color grade has no LUT")
+	}
+	if e.prog == (shaders.Program{}) {
+		prog, err := compile("lut.glsl")
+		if err != nil {
+			return err
+		}
+		e.prog = prog
+	}
+	b.add(e.prog, 1, b.read("u_image\x00", b.output()), shaders.PipelineInput{Uniform: "u_lut\x00", Texture: e.LUT})
+	return nil
+}
+
+func (e *ColorGrade) setUniforms() error {
+	e.prog.Bind()
+	return shaders.IgnoreNoUniform(e.prog.SetUniformName1f("u_intensity\x00", orDefault(e.Intensity, 1)))
+}
+
+func (e *ColorGrade) delete() {
+	e.prog.Delete()
+	e.prog = shaders.Program{}
+}
+
+// IdentityLUT returns a lookup table of the given size which maps colors to
+// themselves, the layout ColorGrade expects. Size must be at least 2, sizes of
+// 16, 32 and 64 are common.
+// Its Pix are uploaded as is with shaders.NewTexture and FormatRGBA8.
+func IdentityLUT(size int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, size*size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size*size; x++ {
+			r, b := x%size, x/size
+			i := img.PixOffset(x, y)
+			img.Pix[i+0] = uint8((r*255 + (size-1)/2) / (size - 1))
+			img.Pix[i+1] = uint8((y*255 + (size-1)/2) / (size - 1))
+			img.Pix[i+2] = uint8((b*255 + (size-1)/2) / (size - 1))
+			img.Pix[i+3] = 255
+		}
+	}
+	return img
+}
+
+// Vignette darkens the image towards its corners.
+type Vignette struct {
+	// Strength is how dark the corners get, from 0 to 1. The zero value is 0.5.
+	Strength float32
+	// Radius is the distance from the center, with the corners at 0.707,
+	// at which darkening starts. The zero value is 0.4.
+	Radius float32
+	// Softness is the distance over which darkening reaches full strength. The zero value is 0.4.
+	Softness float32
+	prog     shaders.Program
+}
+
+func (e *Vignette) build(b *builder) error {
+	return buildSingle(b, &e.prog, "vignette.glsl")
+}
+
+func (e *Vignette) setUniforms() error {
+	e.prog.Bind()
+	err := shaders.IgnoreNoUniform(e.prog.SetUniformName1f("u_strength\x00", orDefault(e.Strength, 0.5)))
+	if err == nil {
+		err = shaders.IgnoreNoUniform(e.prog.SetUniformName1f("u_radius\x00", orDefault(e.Radius, 0.4)))
+	}
+	if err == nil {
+		err = shaders.IgnoreNoUniform(e.prog.SetUniformName1f("u_softness\x00", orDefault(e.Softness, 0.4)))
+	}
+	return err
+}
+
+func (e *Vignette) delete() {
+	e.prog.Delete()
+	e.prog = shaders.Program{}
+}
+
+// Tonemapping operators.
+const (
+	// TonemapACES is the ACES filmic curve, desaturating highlights like film.
+	TonemapACES = iota
+	// TonemapReinhard maps a color c to c/(1+c).
+	TonemapReinhard
+	// TonemapClamp clamps colors to display range.
+	TonemapClamp
+)
+
+// Tonemap maps high dynamic range colors to the display range and applies
+// gamma correction. It usually follows the effects working on scene colors,
+// i.e: Bloom, and precedes those working on display colors, i.e: FXAA.
+type Tonemap struct {
+	// Operator is one of TonemapACES, TonemapReinhard or TonemapClamp.
+	Operator int
+	// Exposure scales colors before mapping them. The zero value is 1.
+	Exposure float32
+	// Gamma is the display gamma. The zero value is 2.2, 1 disables gamma correction.
+	Gamma float32
+	prog  shaders.Program
+}
+
+func (e *Tonemap) build(b *builder) error {
+	if e.Operator < TonemapACES || e.Operator > TonemapClamp {
+		return fmt.Errorf("postfx: unknown tonemapping operator %d", e.Operator)
+	}
+	return buildSingle(b, &e.prog, "tonemap.glsl")
+}
+
+func (e *Tonemap) setUniforms() error {
+	e.prog.Bind()
+	err := shaders.IgnoreNoUniform(e.prog.SetUniformName1i("u_operator\x00", int32(e.Operator)))
+	if err == nil {
+		err = shaders.IgnoreNoUniform(e.prog.SetUniformName1f("u_exposure\x00", orDefault(e.Exposure, 1)))
+	}
+	if err == nil {
+		err = shaders.IgnoreNoUniform(e.prog.SetUniformName1f("u_gamma\x00", orDefault(e.Gamma, 2.2)))
+	}
+	return err
+}
+
+func (e *Tonemap) delete() {
+	e.prog.Delete()
+	e.prog = shaders.Program{}
+}
+
+// Custom is an effect defined by a combined shader file, see
+// shaders.ParseCombinedBasic, whose fragment shader samples the output of the
+// previous effect with a sampler2D named u_image. The vertex stage may be
+// omitted, in which case the shared vertex stage of the effects passes the
+// texture coordinates in vec2 v_uv.
+type Custom struct {
+	// Name identifies the effect in errors.
+	Name   string
+	Source string
+	// Scale is the resolution of the effect relative to the scene. The zero value is 1.
+	Scale float32
+	// SetUniforms, if not nil, is called with the bound program every frame before rendering.
+	SetUniforms func(prog shaders.Program) error
+	prog        shaders.Program
+}
+
+func (e *Custom) build(b *builder) error {
+	if e.prog == (shaders.Program{}) {
+		name := e.Name
+		if name == "" {
+			name = "custom effect"
+		}
+		prog, err := compileSource(name, e.Source)
+		if err != nil {
+			return err
+		}
+		e.prog = prog
+	}
+	b.add(e.prog, e.Scale, b.read("u_image\x00", b.output()))
+	return nil
+}
+
+func (e *Custom) setUniforms() error {
+	if e.SetUniforms == nil {
+		return nil
+	}
+	e.prog.Bind()
+	return e.SetUniforms(e.prog)
+}
+
+func (e *Custom) delete() {
+	e.prog.Delete()
+	e.prog = shaders.Program{}
+}
+
+// copyEffect presents the scene of a chain with no effects.
+type copyEffect struct {
+	prog shaders.Program
+}
+
+func (e *copyEffect) build(b *builder) error {
+	return buildSingle(b, &e.prog, "copy.glsl")
+}
+
+func (e *copyEffect) setUniforms() error { return nil }
+
+func (e *copyEffect) delete() {
+	e.prog.Delete()
+	e.prog = shaders.Program{}
+}
+
+// buildSingle adds the pass of an effect drawn by a single program compiled from file.
+func buildSingle(b *builder, prog *shaders.Program, file string) error {
+	if *prog == (shaders.Program{}) {
+		p, err := compile(file)
+		if err != nil {
+			return err
+		}
+		*prog = p
+	}
+	b.add(*prog, 1, b.read("u_image\x00", b.output()))
+	return nil
+}
+
+func orDefault(v, def float32) float32 {
+	if v == 0 {
+		return def
+	}
+	return v
+}
@@ -0,0 +1,41 @@
+// Fast approximate anti-aliasing, the FXAA 3.11 console variant. It expects
+// colors in display range, so it runs after tonemapping.
+#shader fragment
+#version 330
+
+in vec2 v_uv;
+out vec4 fragColor;
+
+uniform sampler2D u_image;
+
+const float reduceMin = 1.0/128.0;
+const float reduceMul = 1.0/8.0;
+const float spanMax = 8.0;
+
+float luma(vec3 c) {
+	return dot(c, vec3(0.299, 0.587, 0.114));
+}
+
+void main() {
+	vec2 texel = 1.0 / vec2(textureSize(u_image, 0));
+	vec4 color = texture(u_image, v_uv);
+	float lumaM = luma(color.rgb);
+	float lumaNW = luma(texture(u_image, v_uv + vec2(-1.0, -1.0)*texel).rgb);
+	float lumaNE = luma(texture(u_image, v_uv + vec2(1.0, -1.0)*texel).rgb);
+	float lumaSW = luma(texture(u_image, v_uv + vec2(-1.0, 1.0)*texel).rgb);
+	float lumaSE = luma(texture(u_image, v_uv + vec2(1.0, 1.0)*texel).rgb);
+	float lumaMin = min(lumaM, min(min(lumaNW, lumaNE), min(lumaSW, lumaSE)));
+	float lumaMax = max(lumaM, max(max(lumaNW, lumaNE), max(lumaSW, lumaSE)));
+
+	// The edge runs perpendicular to the luma gradient.
+	vec2 dir = vec2(-((lumaNW + lumaNE) - (lumaSW + lumaSE)), (lumaNW + lumaSW) - (lumaNE + lumaSE));
+	float dirReduce = max((lumaNW + lumaNE + lumaSW + lumaSE)*0.25*reduceMul, reduceMin);
+	float rcpDirMin = 1.0 / (min(abs(dir.x), abs(dir.y)) + dirReduce);
+	dir = clamp(dir*rcpDirMin, vec2(-spanMax), vec2(spanMax)) * texel;
+
+	vec3 rgbA = 0.5*(texture(u_image, v_uv + dir*(1.0/3.0 - 0.5)).rgb + texture(u_image, v_uv + dir*(2.0/3.0 - 0.5)).rgb);
+	vec3 rgbB = 0.5*rgbA + 0.25*(texture(u_image, v_uv - 0.5*dir).rgb + texture(u_image, v_uv + 0.5*dir).rgb);
+	float lumaB = luma(rgbB);
+	// The wider sample crossed another edge if it left the local luma range.
+	fragColor = vec4(lumaB < lumaMin || lumaB > lumaMax ? rgbA : rgbB, color.a);
+}
@@ -0,0 +1,31 @@
+// Color grading with a lookup table stored as a strip of size*size by size
+// texels: blue selects one of size squares, red is the column within it and
+// green the row.
+#shader fragment
+#version 330
+
+in vec2 v_uv;
+out vec4 fragColor;
+
+uniform sampler2D u_image;
+uniform sampler2D u_lut;
+// u_intensity mixes between the input, 0, and the graded color, 1.
+uniform float u_intensity;
+
+vec3 grade(vec3 c) {
+	float size = float(textureSize(u_lut, 0).y);
+	c = clamp(c, 0.0, 1.0);
+	float slice = c.b*(size - 1.0);
+	float slice0 = floor(slice);
+	float slice1 = min(slice0 + 1.0, size - 1.0);
+	// Sample texel centers so neighboring squares do not bleed in.
+	vec2 uv = vec2((c.r*(size - 1.0) + 0.5) / (size*size), (c.g*(size - 1.0) + 0.5) / size);
+	vec3 a = texture(u_lut, uv + vec2(slice0/size, 0.0)).rgb;
+	vec3 b = texture(u_lut, uv + vec2(slice1/size, 0.0)).rgb;
+	return mix(a, b, slice - slice0);
+}
+
+void main() {
+	vec4 color = texture(u_image, v_uv);
+	fragColor = vec4(mix(color.rgb, grade(color.rgb), u_intensity), color.a);
+}
@@ -0,0 +1,282 @@
+// Package postfx applies a chain of full-screen effects to a rendered scene:
+// bloom, gaussian blur, FXAA, color grading with a lookup table, vignette and
+// tonemapping. Each effect is a combined shader file, see
+// shaders.ParseCombinedBasic, whose fragment stage samples the previous effect
+// at the texture coordinates v_uv of a shared vertex stage. Custom effects are
+// added with Custom.
+//
+//	chain, err := postfx.NewChain(postfx.Config{Width: 800, Height: 800},
+//		&postfx.Bloom{Threshold: 1, Intensity: 0.6},
+//		&postfx.Tonemap{Exposure: 1.2},
+//		&postfx.FXAA{},
+//	)
+//	if err != nil {
+//		return err
+//	}
+//	defer chain.Delete()
+//	err = a.Run(nil, func(f app.Frame) error {
+//		if err := chain.Resize(a.Size()); err != nil {
+//			return err
+//		}
+//		chain.Begin()
+//		err := drawScene()
+//		if err != nil {
+//			return err
+//		}
+//		return chain.End(a.Renderer, &a.Uniforms)
+//	})
+package postfx
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/soypat/shaders"
+)
+
+//go:embed *.glsl
+var sources embed.FS
+
+// vertexSource is the vertex stage of effects whose file has none, it passes texture coordinates v_uv.
+//
+//go:embed fullscreen.vert
+var vertexSource string
+
+// Config configures a Chain.
+type Config struct {
+	// Width and Height of the scene in pixels.
+	Width, Height int
+	// Format of the scene and the intermediate targets. The zero value is
+	// FormatRGBA16F, or FormatRGBA8 if the context can not render to it,
+	// so bloom and tonemapping get colors brighter than 1.
+	Format shaders.TextureFormat
+	// Depth is the depth format of the scene target. The zero value is FormatDepth24Stencil8.
+	Depth shaders.TextureFormat
+}
+
+// Effect is a full-screen effect of a Chain, i.e: &Bloom{}. Effects own
+// their programs, created by the first chain they are added to, so an
+// effect belongs to a single chain. Fields of effects may be changed between
+// frames.
+type Effect interface {
+	// build compiles the programs of the effect if it was not built before
+	// and adds the passes rendering it.
+	build(b *builder) error
+	// setUniforms sets the fields of the effect as uniforms of its programs.
+	setUniforms() error
+	// delete deletes the programs of the effect.
+	delete()
+}
+
+// Chain renders a scene to an offscreen target and applies effects to it in
+// order, the last effect rendering to the framebuffer bound on Begin.
+type Chain struct {
+	cfg      Config
+	scene    *shaders.Framebuffer
+	effects  []Effect
+	pipeline *shaders.Pipeline
+	// present copies the scene if there are no effects.
+	present *copyEffect
+}
+
+// NewChain creates the scene target and the programs of the effects with the current OpenGL context.
+func NewChain(cfg Config, effects ...Effect) (_ *Chain, err error) {
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, errors.New("postfx: dimensions must be positive")
+	}
+	if cfg.Depth == (shaders.TextureFormat{}) {
+		cfg.Depth = shaders.FormatDepth24Stencil8
+	}
+	c := &Chain{cfg: cfg, effects: effects}
+	defer func() {
+		if err != nil {
+			c.Delete()
+		}
+	}()
+	if err := c.createScene(); err != nil {
+		return nil, err
+	}
+	if err := c.build(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// createScene creates the scene framebuffer trying RGBA16F before RGBA8 if no format was configured.
+func (c *Chain) createScene() (err error) {
+	formats := []shaders.TextureFormat{c.cfg.Format}
+	if c.cfg.Format == (shaders.TextureFormat{}) {
+		formats = []shaders.TextureFormat{shaders.FormatRGBA16F, shaders.FormatRGBA8}
+	}
+	for _, format := range formats {
+		c.scene, err = shaders.NewFramebuffer(shaders.FramebufferConfig{
+			Width:  c.cfg.Width,
+			Height: c.cfg.Height,
+			Color:  []shaders.TextureFormat{format},
+			Depth:  c.cfg.Depth,
+		})
+		if err == nil {
+			c.cfg.Format = format
+			return nil
+		}
+		shaders.ClearErrors()
+	}
+	return fmt.Errorf("postfx: scene target: %w", err)
+}
+
+// build creates the pipeline of the effects reading the scene.
+func (c *Chain) build() error {
+	if c.pipeline != nil {
+		c.pipeline.Delete()
+		c.pipeline = nil
+	}
+	effects := c.effects
+	if len(effects) == 0 {
+		if c.present == nil {
+			c.present = &copyEffect{}
+		}
+		effects = []Effect{c.present}
+	}
+	b := &builder{scene: c.scene.Color(0), format: c.cfg.Format}
+	for _, e := range effects {
+		if err := e.build(b); err != nil {
+			return err
+		}
+	}
+	b.passes[len(b.passes)-1].Screen = true
+	pipeline, err := shaders.NewPipeline(c.cfg.Width, c.cfg.Height, b.passes...)
+	if err != nil {
+		return fmt.Errorf("postfx: %w", err)
+	}
+	c.pipeline = pipeline
+	return nil
+}
+
+// Append adds effects to the end of the chain.
+func (c *Chain) Append(effects ...Effect) error {
+	c.effects = append(c.effects, effects...)
+	return c.build()
+}
+
+// Begin binds the scene target, the scene is drawn between Begin and End.
+// The scene is not cleared.
+func (c *Chain) Begin() {
+	c.scene.Bind()
+}
+
+// End unbinds the scene target and renders the effects, the last one to the
+// framebuffer bound before Begin. u is passed to Pipeline.Render and may be nil.
+func (c *Chain) End(r *shaders.Renderer, u *shaders.StandardUniforms) error {
+	c.scene.Unbind()
+	for _, e := range c.effects {
+		if err := e.setUniforms(); err != nil {
+			return err
+		}
+	}
+	return c.pipeline.Render(r, u)
+}
+
+// Scene returns the scene target.
+func (c *Chain) Scene() *shaders.Framebuffer { return c.scene }
+
+// Resize recreates the targets for a width by height scene if the size changed,
+// i.e: when the window is resized.
+func (c *Chain) Resize(width, height int) error {
+	if width <= 0 || height <= 0 {
+		return errors.New("postfx: dimensions must be positive")
+	}
+	if width == c.cfg.Width && height == c.cfg.Height {
+		return nil
+	}
+	c.cfg.Width, c.cfg.Height = width, height
+	c.scene.Delete()
+	if err := c.createScene(); err != nil {
+		return err
+	}
+	// Pipeline inputs hold the scene texture, so the pipeline is rebuilt.
+	return c.build()
+}
+
+// Delete deletes the targets of the chain and the programs of its effects.
+func (c *Chain) Delete() {
+	if c.pipeline != nil {
+		c.pipeline.Delete()
+	}
+	if c.scene != nil {
+		c.scene.Delete()
+	}
+	for _, e := range c.effects {
+		e.delete()
+	}
+	if c.present != nil {
+		c.present.delete()
+	}
+	*c = Chain{}
+}
+
+// builder accumulates the pipeline passes of a chain's effects.
+type builder struct {
+	passes []shaders.PipelinePass
+	scene  shaders.Texture
+	format shaders.TextureFormat
+}
+
+// output returns the name of the output of the last pass, the
+// input of the next effect. It is empty for the scene.
+func (b *builder) output() string {
+	if len(b.passes) == 0 {
+		return ""
+	}
+	return b.passes[len(b.passes)-1].Name
+}
+
+// read returns the input sampling the output of the named pass, or the scene if name is empty.
+func (b *builder) read(uniform, name string) shaders.PipelineInput {
+	if name == "" {
+		return shaders.PipelineInput{Uniform: uniform, Texture: b.scene}
+	}
+	return shaders.PipelineInput{Uniform: uniform, Pass: name}
+}
+
+// add adds a pass drawing prog at scale and returns its name.
+func (b *builder) add(prog shaders.Program, scale float32, inputs ...shaders.PipelineInput) string {
+	name := fmt.Sprintf("pass%d", len(b.passes))
+	b.passes = append(b.passes, shaders.PipelinePass{
+		Name:    name,
+		Program: prog,
+		Inputs:  inputs,
+		Format:  b.format,
+		Scale:   scale,
+	})
+	return name
+}
+
+// compile compiles an embedded effect file.
+func compile(name string) (shaders.Program, error) {
+	src, err := sources.ReadFile(name)
+	if err != nil {
+		return shaders.Program{}, err
+	}
+	return compileSource(name, string(src))
+}
+
+// compileSource compiles a combined shader file, using the shared vertex stage if it has none.
+func compileSource(name, src string) (shaders.Program, error) {
+	vertex, fragment, err := shaders.ParseCombinedBasic(strings.NewReader(src))
+	if err != nil {
+		return shaders.Program{}, fmt.Errorf("postfx: %s: %w", name, err)
+	}
+	if fragment == "" {
+		return shaders.Program{}, fmt.Errorf("postfx: %s: missing fragment stage", name)
+	}
+	if vertex == "" {
+		vertex = vertexSource + "\x00"
+	}
+	prog, err := shaders.NewProgram(shaders.ShaderSource{Vertex: vertex, Fragment: fragment})
+	if err != nil {
+		return shaders.Program{}, fmt.Errorf("postfx: %s: %w", name, err)
+	}
+	return prog, nil
+}
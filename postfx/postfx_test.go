@@ -0,0 +1,205 @@
+package postfx
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/soypat/shaders"
+	"github.com/soypat/shaders/backend/fake"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+const invertSource = `#shader fragment
+#version 330
+in vec2 v_uv;
+out vec4 fragColor;
+uniform sampler2D u_image;
+void main() { fragColor = vec4(1.0) - texture(u_image, v_uv); }
+`
+
+// Scenes are 40x20 pixels and the default framebuffer, which screen passes render to, is 64x32.
+var testConfig = Config{Width: 40, Height: 20}
+
+func newFake(t *testing.T) *fake.Backend {
+	t.Helper()
+	b := fake.New(64, 32)
+	shaders.SetBackend(b)
+	return b
+}
+
+// render renders a frame of the chain and returns the draws of its passes.
+func render(t *testing.T, b *fake.Backend, r *shaders.Renderer, c *Chain) []fake.Draw {
+	t.Helper()
+	n := len(b.Draws)
+	c.Begin()
+	if err := c.End(r, nil); err != nil {
+		t.Fatal(err)
+	}
+	return b.Draws[n:]
+}
+
+// checkPasses checks the draws are of passes rendered at scales of a width by height scene, the
+// last one to the default framebuffer.
+func checkPasses(t *testing.T, draws []fake.Draw, width, height int, scales ...float32) {
+	t.Helper()
+	if len(draws) != len(scales) {
+		t.Fatalf("got %d passes, want %d", len(draws), len(scales))
+	}
+	for i, d := range draws {
+		want := [4]int32{0, 0, int32(float32(width)*scales[i] + 0.5), int32(float32(height)*scales[i] + 0.5)}
+		screen := i == len(draws)-1
+		if screen {
+			want = [4]int32{0, 0, 64, 32}
+		}
+		if (d.Framebuffer == 0) != screen {
+			t.Errorf("pass %d: rendered to framebuffer %d, only the last pass renders to the screen", i, d.Framebuffer)
+		}
+		if d.Viewport != want {
+			t.Errorf("pass %d: got viewport %v, want %v", i, d.Viewport, want)
+		}
+	}
+}
+
+func TestChainPasses(t *testing.T) {
+	b := newFake(t)
+	img := IdentityLUT(2)
+	lut, err := shaders.NewTexture(img.Rect.Dx(), img.Rect.Dy(), shaders.FormatRGBA8, img.Pix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lut.Delete()
+	r := shaders.NewRenderer()
+	tests := []struct {
+		name   string
+		effect Effect
+		scales []float32
+	}{
+		{"blur", &Blur{}, []float32{1, 1}},
+		{"half resolution blur", &Blur{Scale: 0.5}, []float32{0.5, 0.5}},
+		{"bloom", &Bloom{}, []float32{0.5, 0.5, 0.5, 1}},
+		{"quarter resolution bloom", &Bloom{Scale: 0.25}, []float32{0.25, 0.25, 0.25, 1}},
+		{"fxaa", &FXAA{}, []float32{1}},
+		{"color grade", &ColorGrade{LUT: lut}, []float32{1}},
+		{"vignette", &Vignette{}, []float32{1}},
+		{"tonemap", &Tonemap{Operator: TonemapReinhard}, []float32{1}},
+		{"custom", &Custom{Source: invertSource, Scale: 0.5}, []float32{0.5}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := NewChain(testConfig, test.effect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer c.Delete()
+			// Followed by another effect all passes render to the intermediate targets.
+			if err := c.Append(&Vignette{}); err != nil {
+				t.Fatal(err)
+			}
+			draws := render(t, b, r, c)
+			checkPasses(t, draws, testConfig.Width, testConfig.Height, append(test.scales, 1)...)
+		})
+	}
+}
+
+func TestChainAppendResize(t *testing.T) {
+	b := newFake(t)
+	r := shaders.NewRenderer()
+	c, err := NewChain(testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Delete()
+	// With no effects the scene is copied to the screen.
+	draws := render(t, b, r, c)
+	checkPasses(t, draws, 40, 20, 1)
+	if b.Bound(gl.DRAW_FRAMEBUFFER) != 0 {
+		t.Error("End did not restore the default framebuffer")
+	}
+
+	if err := c.Append(&Bloom{}, &Tonemap{}); err != nil {
+		t.Fatal(err)
+	}
+	draws = render(t, b, r, c)
+	checkPasses(t, draws, 40, 20, 0.5, 0.5, 0.5, 1, 1)
+	objects := b.Objects()
+
+	if err := c.Resize(80, 40); err != nil {
+		t.Fatal(err)
+	}
+	if w, h := c.Scene().Size(); w != 80 || h != 40 {
+		t.Errorf("got scene of %dx%d, want 80x40", w, h)
+	}
+	draws = render(t, b, r, c)
+	checkPasses(t, draws, 80, 40, 0.5, 0.5, 0.5, 1, 1)
+	if b.Objects() != objects {
+		t.Errorf("resizing changed the object count from %d to %d", objects, b.Objects())
+	}
+	// The rebuilt pipeline reads the new scene.
+	c.Begin()
+	scene := b.Framebuffer(b.Bound(gl.DRAW_FRAMEBUFFER)).Attachments[gl.COLOR_ATTACHMENT0].Texture
+	c.Scene().Unbind()
+	if draws[0].Textures[0] != scene || draws[3].Textures[0] != scene {
+		t.Errorf("bloom read textures %d and %d, want scene texture %d", draws[0].Textures[0], draws[3].Textures[0], scene)
+	}
+	if err := c.Resize(0, 40); err == nil {
+		t.Error("expected error resizing to zero width")
+	}
+}
+
+func TestChainErrors(t *testing.T) {
+	b := newFake(t)
+	tests := []struct {
+		name    string
+		effect  Effect
+		wantErr string
+	}{
+		{"color grade without LUT", &ColorGrade{}, "no LUT"},
+		{"negative tonemap operator", &Tonemap{Operator: -1}, "unknown tonemapping operator -1"},
+		{"unknown tonemap operator", &Tonemap{Operator: TonemapClamp + 1}, "unknown tonemapping operator 3"},
+		{"custom without fragment stage", &Custom{Name: "empty", Source: "#shader vertex\nvoid main() {}\n"}, "empty: missing fragment stage"},
+		{"custom compile error", &Custom{Name: "broken", Source: "#shader fragment\n#error broken\n"}, "broken"},
+	}
+	for _, test := range tests {
+		before := b.Objects()
+		_, err := NewChain(testConfig, &Vignette{}, test.effect)
+		if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+			t.Errorf("%s: got error %v, want %q", test.name, err, test.wantErr)
+		}
+		shaders.ClearErrors()
+		if b.Objects() != before {
+			t.Errorf("%s: %d objects leaked", test.name, b.Objects()-before)
+		}
+	}
+	if _, err := NewChain(Config{Width: 0, Height: 20}); err == nil {
+		t.Error("expected error creating a chain with no width")
+	}
+}
+
+func TestIdentityLUT(t *testing.T) {
+	tests := []struct {
+		size int
+		x, y int
+		want color.NRGBA
+	}{
+		{size: 2, x: 0, y: 0, want: color.NRGBA{0, 0, 0, 255}},
+		{size: 2, x: 1, y: 0, want: color.NRGBA{255, 0, 0, 255}},
+		{size: 2, x: 2, y: 1, want: color.NRGBA{0, 255, 255, 255}},
+		{size: 2, x: 3, y: 1, want: color.NRGBA{255, 255, 255, 255}},
+		// Size 4 steps by 255/3, square 1 column 1 row 2.
+		{size: 4, x: 5, y: 2, want: color.NRGBA{85, 170, 85, 255}},
+		{size: 4, x: 14, y: 3, want: color.NRGBA{170, 255, 255, 255}},
+		// Size 16 steps by 17.
+		{size: 16, x: 16*3 + 5, y: 7, want: color.NRGBA{85, 119, 51, 255}},
+	}
+	for _, test := range tests {
+		img := IdentityLUT(test.size)
+		if want := image.Rect(0, 0, test.size*test.size, test.size); img.Rect != want {
+			t.Fatalf("size %d: got bounds %v, want %v", test.size, img.Rect, want)
+		}
+		if got := img.NRGBAAt(test.x, test.y); got != test.want {
+			t.Errorf("size %d: got %v at (%d,%d), want %v", test.size, got, test.x, test.y, test.want)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Tonemapping maps high dynamic range colors to the display range and
+// applies gamma correction.
+#shader fragment
+#version 330
+
+in vec2 v_uv;
+out vec4 fragColor;
+
+uniform sampler2D u_image;
+// u_operator is 0 for the ACES filmic curve, 1 for Reinhard and 2 to clamp.
+uniform int u_operator;
+uniform float u_exposure;
+uniform float u_gamma;
+
+// Krzysztof Narkowicz's fit of the ACES filmic curve.
+vec3 aces(vec3 x) {
+	return clamp((x*(2.51*x + 0.03)) / (x*(2.43*x + 0.59) + 0.14), 0.0, 1.0);
+}
+
+void main() {
+	vec4 color = texture(u_image, v_uv);
+	vec3 c = max(color.rgb*u_exposure, 0.0);
+	if (u_operator == 0) {
+		c = aces(c);
+	} else if (u_operator == 1) {
+		c = c / (1.0 + c);
+	} else {
+		c = clamp(c, 0.0, 1.0);
+	}
+	fragColor = vec4(pow(c, vec3(1.0/u_gamma)), color.a);
+}
@@ -0,0 +1,20 @@
+// Vignette darkens the image towards its corners.
+#shader fragment
+#version 330
+
+in vec2 v_uv;
+out vec4 fragColor;
+
+uniform sampler2D u_image;
+uniform float u_strength;
+// u_radius is the distance from the center, where the corners are at 0.707,
+// at which darkening starts. It reaches full strength u_softness further.
+uniform float u_radius;
+uniform float u_softness;
+
+void main() {
+	vec4 color = texture(u_image, v_uv);
+	float d = length(v_uv - 0.5);
+	float v = 1.0 - smoothstep(u_radius, u_radius + u_softness, d);
+	fragColor = vec4(color.rgb*mix(1.0, v, u_strength), color.a);
+}
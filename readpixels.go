@@ -0,0 +1,357 @@
+package shaders
+
+import (
+	"errors"
+	"image"
+	"unsafe"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// ReadRGBA reads a rectangle of the current read framebuffer into an RGBA
+// image. x and y are the coordinates of the lower left corner of the rectangle
+// in OpenGL window coordinates. The returned image has its origin at the
+// top left as is convention in Go, so rows are flipped with respect to OpenGL.
+// Reading the default framebuffer this way is how screenshots are taken.
+func ReadRGBA(x, y, width, height int) (*image.RGBA, error) {
+	if err := checkReadSize(width, height); err != nil {
+		return nil, err
+	}
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	err := readPixels(x, y, width, height, gl.RGBA, gl.UNSIGNED_BYTE, unsafe.Pointer(&img.Pix[0]))
+	if err != nil {
+		return nil, err
+	}
+	flipRows(img.Pix, img.Stride)
+	return img, nil
+}
+
+// ReadNRGBA64 is like ReadRGBA but reads 16 bits per channel. Useful
+// for framebuffers with more than 8 bits of precision, i.e: FormatRGBA16.
+func ReadNRGBA64(x, y, width, height int) (*image.NRGBA64, error) {
+	if err := checkReadSize(width, height); err != nil {
+		return nil, err
+	}
+	img := image.NewNRGBA64(image.Rect(0, 0, width, height))
+	err := readPixels(x, y, width, height, gl.RGBA, gl.UNSIGNED_SHORT, unsafe.Pointer(&img.Pix[0]))
+	if err != nil {
+		return nil, err
+	}
+	flipRows(img.Pix, img.Stride)
+	toBigEndian16(img.Pix)
+	return img, nil
+}
+
+// ReadFloat32 reads RGBA float values from the current read framebuffer.
+// The returned slice has 4*width*height elements with rows ordered
+// top to bottom like ReadRGBA. Values are not clamped for float formats
+// such as FormatRGBA32F.
+func ReadFloat32(x, y, width, height int) ([]float32, error) {
+	if err := checkReadSize(width, height); err != nil {
+		return nil, err
+	}
+	data := make([]float32, 4*width*height)
+	err := readPixels(x, y, width, height, gl.RGBA, gl.FLOAT, unsafe.Pointer(&data[0]))
+	if err != nil {
+		return nil, err
+	}
+	flipRows(data, 4*width)
+	return data, nil
+}
+
+// ReadRGBA reads the whole of color attachment i of the framebuffer into an RGBA image.
+// The framebuffer is bound as the read framebuffer on return.
+func (fb *Framebuffer) ReadRGBA(i int) (*image.RGBA, error) {
+	if err := fb.bindRead(i); err != nil {
+		return nil, err
+	}
+	return ReadRGBA(0, 0, int(fb.width), int(fb.height))
+}
+
+// ReadNRGBA64 reads the whole of color attachment i of the framebuffer into an NRGBA64 image.
+// The framebuffer is bound as the read framebuffer on return.
+func (fb *Framebuffer) ReadNRGBA64(i int) (*image.NRGBA64, error) {
+	if err := fb.bindRead(i); err != nil {
+		return nil, err
+	}
+	return ReadNRGBA64(0, 0, int(fb.width), int(fb.height))
+}
+
+// ReadFloat32 reads the whole of color attachment i of the framebuffer as RGBA floats.
+// The framebuffer is bound as the read framebuffer on return.
+func (fb *Framebuffer) ReadFloat32(i int) ([]float32, error) {
+	if err := fb.bindRead(i); err != nil {
+		return nil, err
+	}
+	return ReadFloat32(0, 0, int(fb.width), int(fb.height))
+}
+
+func (fb *Framebuffer) bindRead(i int) error {
+	if i < 0 || i >= len(fb.color) {
+		return errors.New("color attachment out of range")
+	}
+	gl.BindFramebuffer(gl.READ_FRAMEBUFFER, fb.rid)
+	gl.ReadBuffer(gl.COLOR_ATTACHMENT0 + uint32(i))
+	return checkErrorAfter("glReadBuffer")
+}
+
+// ReadRGBA reads the texture's base level into an RGBA image.
+// The texture is left bound.
+func (t Texture) ReadRGBA() (*image.RGBA, error) {
+	if err := t.checkRead(); err != nil {
+		return nil, err
+	}
+	img := image.NewRGBA(image.Rect(0, 0, int(t.width), int(t.height)))
+	err := t.getImage(gl.RGBA, gl.UNSIGNED_BYTE, unsafe.Pointer(&img.Pix[0]))
+	if err != nil {
+		return nil, err
+	}
+	flipRows(img.Pix, img.Stride)
+	return img, nil
+}
+
+// ReadNRGBA64 reads the texture's base level into an NRGBA64 image with 16 bits
+// per channel, i.e: for FormatRGBA16 textures. The texture is left bound.
+func (t Texture) ReadNRGBA64() (*image.NRGBA64, error) {
+	if err := t.checkRead(); err != nil {
+		return nil, err
+	}
+	img := image.NewNRGBA64(image.Rect(0, 0, int(t.width), int(t.height)))
+	err := t.getImage(gl.RGBA, gl.UNSIGNED_SHORT, unsafe.Pointer(&img.Pix[0]))
+	if err != nil {
+		return nil, err
+	}
+	flipRows(img.Pix, img.Stride)
+	toBigEndian16(img.Pix)
+	return img, nil
+}
+
+// ReadFloat32 reads the texture's base level as RGBA floats with rows
+// ordered top to bottom. The texture is left bound.
+func (t Texture) ReadFloat32() ([]float32, error) {
+	if err := t.checkRead(); err != nil {
+		return nil, err
+	}
+	data := make([]float32, 4*t.width*t.height)
+	err := t.getImage(gl.RGBA, gl.FLOAT, unsafe.Pointer(&data[0]))
+	if err != nil {
+		return nil, err
+	}
+	flipRows(data, 4*int(t.width))
+	return data, nil
+}
+
+// checkRead returns an error if the texture can not be read back.
+func (t Texture) checkRead() error {
+	if t.rid == 0 {
+		return errors.New("read of zero texture")
+	}
+	return checkReadSize(int(t.width), int(t.height))
+}
+
+func (t Texture) getImage(format, xtype uint32, dst unsafe.Pointer) error {
+	t.Bind()
+	if contextVersion().es {
+		return t.readAttached(format, xtype, dst)
+	}
+	gl.PixelStorei(gl.PACK_ALIGNMENT, 1)
+	gl.GetTexImage(gl.TEXTURE_2D, 0, format, xtype, dst)
+	return checkErrorAfter("glGetTexImage")
+}
+
+// readAttached reads the texture by attaching it to a temporary framebuffer
+// since OpenGL ES lacks glGetTexImage. The read framebuffer binding is restored.
+func (t Texture) readAttached(format, xtype uint32, dst unsafe.Pointer) error {
+	var prevFBO int32
+	gl.GetIntegerv(gl.READ_FRAMEBUFFER_BINDING, &prevFBO)
+	var fbo uint32
+	gl.GenFramebuffers(1, &fbo)
+	trackCreate(kindFramebuffer, fbo)
+	gl.BindFramebuffer(gl.READ_FRAMEBUFFER, fbo)
+	gl.FramebufferTexture2D(gl.READ_FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.TEXTURE_2D, t.rid, 0)
+	err := readPixels(0, 0, int(t.width), int(t.height), format, xtype, dst)
+	gl.BindFramebuffer(gl.READ_FRAMEBUFFER, uint32(prevFBO))
+	if trackDelete(kindFramebuffer, fbo) {
+		gl.DeleteFramebuffers(1, &fbo)
+	}
+	return err
+}
+
+// checkReadSize returns an error if a width*height read is empty. It is checked
+// before the destination is allocated since an empty one has no first element.
+func checkReadSize(width, height int) error {
+	if width <= 0 || height <= 0 {
+		return errors.New("read dimensions must be positive")
+	}
+	return nil
+}
+
+func readPixels(x, y, width, height int, format, xtype uint32, dst unsafe.Pointer) error {
+	// Make sure rows are tightly packed in dst.
+	gl.PixelStorei(gl.PACK_ALIGNMENT, 1)
+	gl.ReadPixels(int32(x), int32(y), int32(width), int32(height), format, xtype, dst)
+	return checkErrorAfter("glReadPixels")
+}
+
+// PixelReader reads RGBA pixels back from the GPU asynchronously using pixel
+// buffer objects. glReadPixels into a pixel buffer returns immediately and a fence
+// lets us know when the copy is done, so reading back does not stall the frame.
+//
+//	pr.Start(0, 0) // After drawing frame.
+//	// ... some frames later:
+//	ready, err := pr.Poll(img)
+type PixelReader struct {
+	width, height int32
+	pbos          [pixelReaderDepth]uint32
+	fences        [pixelReaderDepth]uintptr
+	// Ring buffer indices. Reads are started at head and collected at tail.
+	head, tail, pending int
+}
+
+// pixelReaderDepth is the number of readbacks that may be in flight.
+const pixelReaderDepth = 3
+
+// ErrReaderBusy is returned by PixelReader.Start when all readbacks are in flight.
+var ErrReaderBusy = errors.New("all pixel readbacks in flight")
+
+// NewPixelReader creates a PixelReader for rectangles of width*height pixels.
+func NewPixelReader(width, height int) (*PixelReader, error) {
+	if width <= 0 || height <= 0 {
+		return nil, errors.New("read dimensions must be positive")
+	}
+	pr := &PixelReader{width: int32(width), height: int32(height)}
+	size := 4 * width * height
+	gl.GenBuffers(pixelReaderDepth, &pr.pbos[0])
+	for _, pbo := range pr.pbos {
+		trackCreate(kindBuffer, pbo)
+		gl.BindBuffer(gl.PIXEL_PACK_BUFFER, pbo)
+		gl.BufferData(gl.PIXEL_PACK_BUFFER, size, nil, gl.STREAM_READ)
+	}
+	gl.BindBuffer(gl.PIXEL_PACK_BUFFER, 0)
+	if err := glCheckError(); err != nil {
+		pr.Delete()
+		return nil, err
+	}
+	return pr, nil
+}
+
+// Start starts reading a width*height rectangle with lower left corner at x, y
+// from the current read framebuffer. It returns ErrReaderBusy if Poll has not collected
+// enough of the previous reads.
+func (pr *PixelReader) Start(x, y int) error {
+	if pr.pending == pixelReaderDepth {
+		return ErrReaderBusy
+	}
+	gl.BindBuffer(gl.PIXEL_PACK_BUFFER, pr.pbos[pr.head])
+	gl.PixelStorei(gl.PACK_ALIGNMENT, 1)
+	// With a pixel pack buffer bound the data argument is an offset into the buffer.
+	gl.ReadPixels(int32(x), int32(y), pr.width, pr.height, gl.RGBA, gl.UNSIGNED_BYTE, nil)
+	if err := checkCall("glReadPixels"); err != nil {
+		return err
+	}
+	pr.fences[pr.head] = gl.FenceSync(gl.SYNC_GPU_COMMANDS_COMPLETE, 0)
+	gl.BindBuffer(gl.PIXEL_PACK_BUFFER, 0)
+	if err := glCheckError(); err != nil {
+		return err
+	}
+	pr.head = (pr.head + 1) % pixelReaderDepth
+	pr.pending++
+	return nil
+}
+
+// Poll checks whether the oldest read started is done without blocking. If it is,
+// the pixels are copied into dst, which must be the size of the reader, and ready is true.
+func (pr *PixelReader) Poll(dst *image.RGBA) (ready bool, err error) {
+	if dst == nil {
+		return false, errors.New("nil destination image")
+	}
+	if pr.pending == 0 {
+		return false, nil
+	}
+	if dst.Rect.Dx() != int(pr.width) || dst.Rect.Dy() != int(pr.height) {
+		return false, errors.New("destination image size mismatch")
+	}
+	fence := pr.fences[pr.tail]
+	status := gl.ClientWaitSync(fence, gl.SYNC_FLUSH_COMMANDS_BIT, 0)
+	if status == gl.TIMEOUT_EXPIRED {
+		return false, nil
+	}
+	gl.DeleteSync(fence)
+	pr.fences[pr.tail] = 0
+	if status == gl.WAIT_FAILED {
+		// The read is lost, drop it so the slot can be reused.
+		pr.tail = (pr.tail + 1) % pixelReaderDepth
+		pr.pending--
+		if err := glCheckError(); err != nil {
+			return false, err
+		}
+		return false, errors.New("wait on pixel readback fence failed")
+	}
+
+	rowSize := 4 * int(pr.width)
+	size := rowSize * int(pr.height)
+	gl.BindBuffer(gl.PIXEL_PACK_BUFFER, pr.pbos[pr.tail])
+	ptr := gl.MapBufferRange(gl.PIXEL_PACK_BUFFER, 0, size, gl.MAP_READ_BIT)
+	if ptr != nil {
+		src := unsafe.Slice((*byte)(ptr), size)
+		for y := 0; y < int(pr.height); y++ {
+			// Flip rows while copying.
+			srcRow := src[(int(pr.height)-1-y)*rowSize:][:rowSize]
+			copy(dst.Pix[y*dst.Stride:], srcRow)
+		}
+		gl.UnmapBuffer(gl.PIXEL_PACK_BUFFER)
+	}
+	gl.BindBuffer(gl.PIXEL_PACK_BUFFER, 0)
+	pr.tail = (pr.tail + 1) % pixelReaderDepth
+	pr.pending--
+	if ptr == nil {
+		return false, errors.New("map of pixel buffer failed")
+	}
+	return true, glCheckError()
+}
+
+// Delete deletes the pixel buffers and any pending fences.
+func (pr *PixelReader) Delete() {
+	for i, fence := range pr.fences {
+		if fence != 0 {
+			gl.DeleteSync(fence)
+			pr.fences[i] = 0
+		}
+	}
+	for i, pbo := range pr.pbos {
+		if trackDelete(kindBuffer, pbo) {
+			gl.DeleteBuffers(1, &pr.pbos[i])
+		}
+		pr.pbos[i] = 0
+	}
+	pr.pending = 0
+}
+
+// flipRows flips the rows of a row-major image buffer vertically in place.
+func flipRows[T any](pix []T, stride int) {
+	rows := len(pix) / stride
+	tmp := make([]T, stride)
+	for top, bottom := 0, rows-1; top < bottom; top, bottom = top+1, bottom-1 {
+		topRow := pix[top*stride : (top+1)*stride]
+		bottomRow := pix[bottom*stride : (bottom+1)*stride]
+		copy(tmp, topRow)
+		copy(topRow, bottomRow)
+		copy(bottomRow, tmp)
+	}
+}
+
+// toBigEndian16 converts the native endian shorts OpenGL writes to the big
+// endian order of image.NRGBA64.
+func toBigEndian16(pix []byte) {
+	if isBigEndian() {
+		return
+	}
+	for i := 0; i < len(pix); i += 2 {
+		pix[i], pix[i+1] = pix[i+1], pix[i]
+	}
+}
+
+func isBigEndian() bool {
+	v := uint16(1)
+	return *(*byte)(unsafe.Pointer(&v)) == 0
+}
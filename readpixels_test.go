@@ -0,0 +1,85 @@
+package shaders
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/soypat/shaders/backend/fake"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// failedWait fails waits on fences without setting an OpenGL error, as drivers may do
+// when the context is lost.
+type failedWait struct {
+	*fake.Backend
+	deleted []uintptr
+}
+
+func (b *failedWait) ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32 {
+	return gl.WAIT_FAILED
+}
+
+func (b *failedWait) DeleteSync(sync uintptr) {
+	b.deleted = append(b.deleted, sync)
+	b.Backend.DeleteSync(sync)
+}
+
+func TestPixelReaderPoll(t *testing.T) {
+	b := newFake(t)
+	r := NewRenderer()
+	if err := r.Clear(color.NRGBA{R: 255, A: 255}, 1, 0); err != nil {
+		t.Fatal(err)
+	}
+	pr, err := NewPixelReader(4, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.Delete()
+	if _, err := pr.Poll(nil); err == nil {
+		t.Error("expected error polling into a nil image")
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	if ready, err := pr.Poll(dst); ready || err != nil {
+		t.Errorf("poll with no reads: got ready=%v err=%v", ready, err)
+	}
+	if err := pr.Start(0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pr.Poll(image.NewRGBA(image.Rect(0, 0, 2, 2))); err == nil {
+		t.Error("expected error polling into an image of the wrong size")
+	}
+	ready, err := pr.Poll(dst)
+	if !ready || err != nil {
+		t.Fatalf("got ready=%v err=%v", ready, err)
+	}
+	if got := dst.RGBAAt(3, 1); got != (color.RGBA{R: 255, A: 255}) {
+		t.Errorf("got pixel %v, want red", got)
+	}
+
+	for i := 0; i < pixelReaderDepth; i++ {
+		if err := pr.Start(0, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := pr.Start(0, 0); err != ErrReaderBusy {
+		t.Fatalf("got %v, want ErrReaderBusy", err)
+	}
+	fw := &failedWait{Backend: b}
+	SetBackend(fw)
+	if ready, err := pr.Poll(dst); ready || err == nil {
+		t.Errorf("failed wait: got ready=%v err=%v, want error", ready, err)
+	}
+	if len(fw.deleted) != 1 {
+		t.Errorf("failed wait deleted %d fences, want 1", len(fw.deleted))
+	}
+	SetBackend(b)
+	// The failed read frees its slot and the next read is collected.
+	if err := pr.Start(0, 0); err != nil {
+		t.Errorf("start after failed wait: %v", err)
+	}
+	if ready, err := pr.Poll(dst); !ready || err != nil {
+		t.Errorf("poll after failed wait: got ready=%v err=%v", ready, err)
+	}
+	checkNoGLErrors(t)
+}
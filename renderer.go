@@ -0,0 +1,201 @@
+package shaders
+
+import (
+	"errors"
+	"image/color"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+var (
+	ErrNoProgram     = errors.New("draw with no program bound")
+	ErrNoVertexArray = errors.New("draw with no vertex array bound")
+	ErrNoIndices     = errors.New("draw with empty index buffer")
+	ErrUnsupported   = errors.New("operation not supported by OpenGL context")
+	ErrNoUniform     = errors.New("unable to find uniform in program- did you use the identifier so it was not stripped from program?")
+	ErrNoAttribute   = errors.New("unable to find attribute in program- did you use the identifier so it was not stripped from program?")
+)
+
+// IgnoreNoUniform returns nil if err is ErrNoUniform and err otherwise. It is for
+// setting uniforms the shader may not use, which the compiler optimizes away:
+//
+//	err := shaders.IgnoreNoUniform(prog.SetUniformName1f("u_time\x00", t))
+func IgnoreNoUniform(err error) error {
+	if errors.Is(err, ErrNoUniform) {
+		return nil
+	}
+	return err
+}
+
+// Renderer issues draw calls to the current framebuffer.
+type Renderer struct {
+	// primitive is the mode with which vertices are assembled, i.e: gl.TRIANGLES.
+	primitive uint32
+	// state is the pipeline state requested for subsequent draws.
+	state PipelineState
+	// applied is the shadow copy of the state last sent to OpenGL.
+	// It is only meaningful if appliedValid is true.
+	applied      PipelineState
+	appliedValid bool
+	// baseInstance is true if the context supports ARB_base_instance draws.
+	baseInstance bool
+	// multiDrawIndirect is true if the context supports ARB_multi_draw_indirect.
+	multiDrawIndirect bool
+	// indexedBlend is true if blending may be configured per color attachment.
+	indexedBlend bool
+	// es is true for OpenGL ES contexts, which lack polygon modes.
+	es bool
+	// fullscreenVAO is the empty vertex array bound by DrawFullscreen.
+	fullscreenVAO VertexArray
+}
+
+// NewRenderer returns a Renderer that draws triangles with DefaultPipelineState.
+// There must be a current OpenGL context.
+func NewRenderer() *Renderer {
+	return &Renderer{
+		primitive:         gl.TRIANGLES,
+		state:             DefaultPipelineState,
+		baseInstance:      contextSupports(4, 2, "GL_ARB_base_instance"),
+		multiDrawIndirect: contextSupports(4, 3, "GL_ARB_multi_draw_indirect"),
+		indexedBlend:      contextSupports(4, 0, "GL_ARB_draw_buffers_blend") || contextSupportsES(3, 2, "GL_EXT_draw_buffers_indexed"),
+		es:                contextVersion().es,
+	}
+}
+
+// SetPrimitive sets the primitive mode used in subsequent draws. Valid modes
+// include gl.TRIANGLES, gl.TRIANGLE_STRIP, gl.LINES, gl.POINTS etc.
+func (r *Renderer) SetPrimitive(mode uint32) {
+	r.primitive = mode
+}
+
+// Draw binds the vertex array, index buffer and program and draws
+// all the indices in the index buffer.
+func (r *Renderer) Draw(vao VertexArray, ib IndexBuffer, prog Program) error {
+	if err := r.bind(vao, prog); err != nil {
+		return err
+	}
+	if err := bindIndices(ib); err != nil {
+		return err
+	}
+	gl.DrawElementsWithOffset(r.primitive, ib.count, gl.UNSIGNED_INT, 0)
+	return checkErrorAfter("glDrawElements")
+}
+
+// DrawArrays binds the vertex array and program and draws count vertices
+// starting at vertex first without using an index buffer.
+func (r *Renderer) DrawArrays(vao VertexArray, prog Program, first, count int) error {
+	if err := r.bind(vao, prog); err != nil {
+		return err
+	}
+	gl.DrawArrays(r.primitive, int32(first), int32(count))
+	return checkErrorAfter("glDrawArrays")
+}
+
+// DrawInstanced is like Draw but draws the indexed geometry instances times.
+// The vertex shader may tell instances apart by reading gl_InstanceID.
+func (r *Renderer) DrawInstanced(vao VertexArray, ib IndexBuffer, prog Program, instances int) error {
+	if err := r.bind(vao, prog); err != nil {
+		return err
+	}
+	if err := bindIndices(ib); err != nil {
+		return err
+	}
+	gl.DrawElementsInstanced(r.primitive, ib.count, gl.UNSIGNED_INT, nil, int32(instances))
+	return checkErrorAfter("glDrawElementsInstanced")
+}
+
+// DrawArraysInstanced is like DrawArrays but draws the vertices instances times.
+func (r *Renderer) DrawArraysInstanced(vao VertexArray, prog Program, first, count, instances int) error {
+	if err := r.bind(vao, prog); err != nil {
+		return err
+	}
+	gl.DrawArraysInstanced(r.primitive, int32(first), int32(count), int32(instances))
+	return checkErrorAfter("glDrawArraysInstanced")
+}
+
+// DrawInstancedBaseInstance is like DrawInstanced but per-instance attributes
+// start being read at element baseInstance instead of 0. This way many groups of
+// instances may share a single per-instance buffer. It returns ErrUnsupported
+// if the context is older than OpenGL 4.2 and lacks ARB_base_instance.
+func (r *Renderer) DrawInstancedBaseInstance(vao VertexArray, ib IndexBuffer, prog Program, instances, baseInstance int) error {
+	if !r.baseInstance {
+		return ErrUnsupported
+	}
+	if err := r.bind(vao, prog); err != nil {
+		return err
+	}
+	if err := bindIndices(ib); err != nil {
+		return err
+	}
+	gl.DrawElementsInstancedBaseInstance(r.primitive, ib.count, gl.UNSIGNED_INT, nil, int32(instances), uint32(baseInstance))
+	return checkErrorAfter("glDrawElementsInstancedBaseInstance")
+}
+
+// DrawArraysInstancedBaseInstance is the non-indexed version of DrawInstancedBaseInstance.
+func (r *Renderer) DrawArraysInstancedBaseInstance(vao VertexArray, prog Program, first, count, instances, baseInstance int) error {
+	if !r.baseInstance {
+		return ErrUnsupported
+	}
+	if err := r.bind(vao, prog); err != nil {
+		return err
+	}
+	gl.DrawArraysInstancedBaseInstance(r.primitive, int32(first), int32(count), int32(instances), uint32(baseInstance))
+	return checkErrorAfter("glDrawArraysInstancedBaseInstance")
+}
+
+// Clear clears the color, depth and stencil buffers of the
+// current framebuffer to the values given. Clearing respects the scissor
+// and write masks of the pipeline state.
+func (r *Renderer) Clear(c color.Color, depth float64, stencil int) error {
+	if err := r.applyState(); err != nil {
+		return err
+	}
+	return clearBuffers(c, depth, stencil)
+}
+
+// clearBuffers clears the buffers of the draw framebuffer with the current pipeline state.
+func clearBuffers(c color.Color, depth float64, stencil int) error {
+	nc := color.NRGBA64Model.Convert(c).(color.NRGBA64)
+	const max = 0xffff
+	gl.ClearColor(float32(nc.R)/max, float32(nc.G)/max, float32(nc.B)/max, float32(nc.A)/max)
+	gl.ClearDepth(depth)
+	gl.ClearStencil(int32(stencil))
+	gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT | gl.STENCIL_BUFFER_BIT)
+	return checkErrorAfter("glClear")
+}
+
+// SetViewport sets the viewport transformation from normalized device
+// coordinates to window coordinates. x and y are the lower left corner of the viewport.
+func (r *Renderer) SetViewport(x, y, width, height int) {
+	gl.Viewport(int32(x), int32(y), int32(width), int32(height))
+	logCall("glViewport")
+}
+
+// Viewport returns the current viewport. Framebuffer.Bind and Unbind
+// modify the viewport so the value is always queried from OpenGL.
+func (r *Renderer) Viewport() (x, y, width, height int) {
+	var vp [4]int32
+	gl.GetIntegerv(gl.VIEWPORT, &vp[0])
+	return int(vp[0]), int(vp[1]), int(vp[2]), int(vp[3])
+}
+
+func (r *Renderer) bind(vao VertexArray, prog Program) error {
+	if prog.rid == 0 {
+		return ErrNoProgram
+	}
+	if vao.rid == 0 {
+		return ErrNoVertexArray
+	}
+	prog.Bind()
+	vao.Bind()
+	return r.applyState()
+}
+
+// bindIndices binds the index buffer to the currently bound vertex array.
+func bindIndices(ib IndexBuffer) error {
+	if ib.rid == 0 || ib.count == 0 {
+		return ErrNoIndices
+	}
+	ib.Bind()
+	return nil
+}
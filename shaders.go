@@ -8,7 +8,7 @@ import (
 	"io"
 	"strings"
 
-	"github.com/go-gl/gl/v4.6-core/gl"
+	"github.com/soypat/shaders/internal/gl"
 )
 
 // ParseCombinedBasic parses a file with vertex and fragment #shader pragmas.
@@ -58,6 +58,8 @@ func ParseCombinedBasic(r io.Reader) (vertexSrc, fragSrc string, err error) {
 // CompileBasic compiles two OpenGL vertex and fragment shaders
 // and returns a program with the current OpenGL context.
 // It returns an error if compilation, linking or validation fails.
+// #version directives the context can not compile are rewritten to one it can,
+// so "#version 410 core" shaders also run on OpenGL ES 3.0, see SetVersionAdaptation.
 func CompileBasic(vertexSrcCode, fragmentSrcCode string) (program uint32, err error) {
 	if !strings.HasSuffix(vertexSrcCode, "\x00") {
 		return 0, errors.New("vertex shader source has no null terminator")
@@ -65,45 +67,77 @@ func CompileBasic(vertexSrcCode, fragmentSrcCode string) (program uint32, err er
 	if !strings.HasSuffix(fragmentSrcCode, "\x00") {
 		return 0, errors.New("fragment shader source has no null terminator")
 	}
-	program = gl.CreateProgram()
 	vid, err := compile(gl.VERTEX_SHADER, vertexSrcCode)
 	if err != nil {
 		return 0, fmt.Errorf("vertex shader compile: %w", err)
 	}
+	// Shaders are flagged for deletion and freed once detached from the program.
+	defer gl.DeleteShader(vid)
 	fid, err := compile(gl.FRAGMENT_SHADER, fragmentSrcCode)
 	if err != nil {
 		return 0, fmt.Errorf("fragment shader compile: %w", err)
 	}
+	defer gl.DeleteShader(fid)
+	program = gl.CreateProgram()
 	gl.AttachShader(program, vid)
 	gl.AttachShader(program, fid)
 	gl.LinkProgram(program)
 	log := ivLog(program, gl.LINK_STATUS, gl.GetProgramiv, gl.GetProgramInfoLog)
 	if len(log) > 0 {
+		gl.DeleteProgram(program)
 		return 0, fmt.Errorf("link failed: %v", log)
 	}
-	// We should technically call DetachShader after linking... https://www.youtube.com/watch?v=71BLZwRGUJE&list=PLlrATfBNZ98foTJPJ_Ev03o2oq3-GGOS2&index=7&ab_channel=TheCherno
+	// Linked programs do not need the shader objects. https://www.youtube.com/watch?v=71BLZwRGUJE&list=PLlrATfBNZ98foTJPJ_Ev03o2oq3-GGOS2&index=7&ab_channel=TheCherno
+	gl.DetachShader(program, vid)
+	gl.DetachShader(program, fid)
 	gl.ValidateProgram(program)
 	log = ivLog(program, gl.VALIDATE_STATUS, gl.GetProgramiv, gl.GetProgramInfoLog)
 	if len(log) > 0 {
+		gl.DeleteProgram(program)
 		return 0, fmt.Errorf("validation failed: %v", log)
 	}
+	return program, nil
+}
 
-	// We can clean up.
-	gl.DeleteShader(vid)
-	gl.DeleteShader(fid)
+// CompileCompute compiles an OpenGL compute shader and returns a program
+// with the current OpenGL context. Compute shaders require OpenGL 4.3 or
+// OpenGL ES 3.1, ErrUnsupported is returned otherwise.
+// It returns an error if compilation or linking fails.
+func CompileCompute(computeSrcCode string) (program uint32, err error) {
+	if !strings.HasSuffix(computeSrcCode, "\x00") {
+		return 0, errors.New("compute shader source has no null terminator")
+	}
+	if !hasCompute() {
+		return 0, ErrUnsupported
+	}
+	cid, err := compile(gl.COMPUTE_SHADER, computeSrcCode)
+	if err != nil {
+		return 0, fmt.Errorf("compute shader compile: %w", err)
+	}
+	defer gl.DeleteShader(cid)
+	program = gl.CreateProgram()
+	gl.AttachShader(program, cid)
+	gl.LinkProgram(program)
+	log := ivLog(program, gl.LINK_STATUS, gl.GetProgramiv, gl.GetProgramInfoLog)
+	if len(log) > 0 {
+		gl.DeleteProgram(program)
+		return 0, fmt.Errorf("link failed: %v", log)
+	}
+	gl.DetachShader(program, cid)
 	return program, nil
 }
 
+// compile compiles a shader. Its #version directive is adapted to
+// the current context, see adaptVersion.
 func compile(shaderType uint32, sourceCode string) (uint32, error) {
 	id := gl.CreateShader(shaderType)
-	csources, free := gl.Strs(sourceCode)
-	gl.ShaderSource(id, 1, csources, nil)
-	free()
+	gl.ShaderSource(id, adaptVersion(shaderType, sourceCode))
 	gl.CompileShader(id)
 
 	// We now check the errors during compile, if there were any.
 	log := ivLog(id, gl.COMPILE_STATUS, gl.GetShaderiv, gl.GetShaderInfoLog)
 	if len(log) > 0 {
+		gl.DeleteShader(id)
 		return 0, errors.New(log)
 	}
 	return id, nil
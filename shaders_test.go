@@ -0,0 +1,43 @@
+package shaders
+
+import (
+	"testing"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+func TestCompileBasicCleanup(t *testing.T) {
+	b := newFake(t)
+	const broken = "#version 330\n#error broken\nvoid main() {}\n\x00"
+	tests := []struct {
+		name             string
+		vertex, fragment string
+	}{
+		{"vertex error", broken, testFragment},
+		{"fragment error", testVertex, broken},
+		{"no main", testVertex, "#version 330\nout vec4 color;\n\x00"},
+		{"unterminated vertex", testVertex[:len(testVertex)-1], testFragment},
+		{"unterminated fragment", testVertex, testFragment[:len(testFragment)-1]},
+	}
+	for _, test := range tests {
+		before := b.Objects()
+		program, err := CompileBasic(test.vertex, test.fragment)
+		if err == nil || program != 0 {
+			t.Errorf("%s: got program %d and error %v", test.name, program, err)
+		}
+		if b.Objects() != before {
+			t.Errorf("%s: %d objects leaked", test.name, b.Objects()-before)
+		}
+	}
+	// Only the program outlives a successful compile.
+	before := b.Objects()
+	program, err := CompileBasic(testVertex, testFragment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Objects() != before+1 || len(b.Program(program).Shaders) != 0 {
+		t.Errorf("got %d new objects and %d attached shaders, want only the program", b.Objects()-before, len(b.Program(program).Shaders))
+	}
+	gl.DeleteProgram(program)
+	checkNoGLErrors(t)
+}
@@ -0,0 +1,67 @@
+package shaders
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// shadertoyPrelude declares the inputs of Shadertoy shaders. Shadertoy
+// compiles GLSL ES 3.00 which adaptVersion translates for desktop contexts.
+const shadertoyPrelude = `#version 300 es
+precision highp float;
+precision highp int;
+uniform vec3 iResolution;
+uniform float iTime;
+uniform float iTimeDelta;
+uniform float iFrameRate;
+uniform int iFrame;
+uniform float iChannelTime[4];
+uniform vec3 iChannelResolution[4];
+uniform vec4 iMouse;
+uniform vec4 iDate;
+uniform float iSampleRate;
+uniform sampler2D iChannel0;
+uniform sampler2D iChannel1;
+uniform sampler2D iChannel2;
+uniform sampler2D iChannel3;
+out vec4 ` + fragColorOutput + `;
+#line 1
+`
+
+// shadertoyMain calls mainImage with the fragment shader output.
+const shadertoyMain = `
+void main() {
+	mainImage(` + fragColorOutput + `, gl_FragCoord.xy);
+}
+`
+
+var (
+	glslComments  = regexp.MustCompile(`(?s)//[^\n]*|/\*.*?\*/`)
+	glslMain      = regexp.MustCompile(`\bvoid\s+main\s*\(`)
+	glslMainImage = regexp.MustCompile(`\bvoid\s+mainImage\s*\(`)
+)
+
+// isShadertoy reports whether src is Shadertoy code, which defines mainImage instead of main.
+func isShadertoy(src string) bool {
+	src = glslComments.ReplaceAllString(src, "")
+	return glslMainImage.MatchString(src) && !glslMain.MatchString(src)
+}
+
+// wrapShadertoy declares the Shadertoy inputs before src and appends a main
+// function calling mainImage. Line numbers of src are preserved.
+func wrapShadertoy(src string) string {
+	return shadertoyPrelude + strings.TrimSuffix(src, "\x00") + shadertoyMain + "\x00"
+}
+
+// setShadertoyChannels binds and assigns iChannel0 to iChannel3 to texture units 0 to 3.
+func setShadertoyChannels(p Program) error {
+	p.Bind()
+	for i := 0; i < 4; i++ {
+		name := "iChannel" + strconv.Itoa(i) + "\x00"
+		if err := IgnoreNoUniform(p.SetUniformName1i(name, int32(i))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+package shadertoy
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/soypat/shaders"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// Config configures a Player.
+type Config struct {
+	// Width and Height of the rendered image and of the buffers in pixels.
+	Width, Height int
+	// MediaDir is the directory holding the textures of texture inputs. An input
+	// with Src "/media/a/<hash>.png" is looked up as "<MediaDir>/<hash>.png"
+	// and then as "<MediaDir>/media/a/<hash>.png", so files may be saved flat
+	// or mirroring shadertoy.com paths.
+	MediaDir string
+}
+
+// bufferIDs are the output IDs Shadertoy gives Buffer A to D.
+var bufferIDs = [4]ID{"4dXGR8", "XsXGR8", "4sXGR8", "XdfGR8"}
+
+// Player renders a shader's buffer passes to offscreen framebuffers and its
+// image pass to the current framebuffer. Buffers are double buffered so a pass
+// reading its own buffer reads the previous frame, and a pass reading another
+// buffer reads its latest frame, as in Shadertoy.
+type Player struct {
+	width, height int
+	// buffers are Buffer A to D, nil if the shader lacks the buffer.
+	buffers [4]*buffer
+	// passes are the buffer passes in Shadertoy's order, A to D, and then the image pass.
+	passes []*pass
+	// textures holds media textures, the keyboard and the placeholder for unplayable media.
+	textures []shaders.Texture
+	// formats are the buffer color formats tried in order until the context
+	// renders to one, after which it is the only one.
+	formats []shaders.TextureFormat
+}
+
+type buffer struct {
+	// front holds the latest frame, back is rendered to.
+	front, back *shaders.Framebuffer
+}
+
+type pass struct {
+	name string
+	prog shaders.Program
+	// buffer is the index of the buffer rendered to, -1 for the image pass.
+	buffer   int
+	channels [4]channel
+}
+
+// channel is the texture bound to an iChannel sampler.
+type channel struct {
+	// tex is set for texture, keyboard and unplayable media inputs.
+	tex shaders.Texture
+	// buffer is the index of the buffer read, -1 if tex is set or the channel is unused.
+	buffer    int
+	minFilter int32
+	magFilter int32
+	wrap      int32
+}
+
+// NewPlayer compiles the passes of s and creates their buffers and textures
+// with the current OpenGL context. Sound and cubemap passes are not supported
+// and ignored. Video, music, webcam and microphone inputs read as black since
+// they can not be played offline, and the keyboard as no key pressed.
+func NewPlayer(s *Shader, cfg Config) (_ *Player, err error) {
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, errors.New("shadertoy: dimensions must be positive")
+	}
+	imagePass := s.Pass(TypeImage)
+	if imagePass == nil {
+		return nil, errors.New("shadertoy: shader has no image pass")
+	}
+	formats := []shaders.TextureFormat{shaders.FormatRGBA32F, shaders.FormatRGBA16F, shaders.FormatRGBA8}
+	if caps := shaders.Capabilities(); caps.ES && !caps.HasExtension("GL_OES_texture_float_linear") {
+		// OpenGL ES can not filter 32 bit float textures by default.
+		formats = formats[1:]
+	}
+	p := &Player{width: cfg.Width, height: cfg.Height, formats: formats}
+	defer func() {
+		if err != nil {
+			p.Delete()
+		}
+	}()
+	var common string
+	if c := s.Pass(TypeCommon); c != nil {
+		common = c.Code
+	}
+	// Buffers must exist before their readers are compiled.
+	var passes []*RenderPass
+	for i := range s.RenderPass {
+		rp := &s.RenderPass[i]
+		if rp.Type != TypeBuffer {
+			continue
+		}
+		idx := bufferIndex(rp)
+		if idx < 0 {
+			return nil, fmt.Errorf("shadertoy: unknown buffer %q", rp.Name)
+		}
+		if p.buffers[idx] == nil {
+			if p.buffers[idx], err = p.newBuffer(); err != nil {
+				return nil, fmt.Errorf("shadertoy: %s: %w", rp.Name, err)
+			}
+		}
+		passes = append(passes, rp)
+	}
+	// Shadertoy renders buffers in alphabetical order regardless of tab order.
+	for i := 1; i < len(passes); i++ {
+		for j := i; j > 0 && bufferIndex(passes[j]) < bufferIndex(passes[j-1]); j-- {
+			passes[j], passes[j-1] = passes[j-1], passes[j]
+		}
+	}
+	passes = append(passes, imagePass)
+	for _, rp := range passes {
+		pa, err := p.newPass(rp, common, cfg.MediaDir)
+		if err != nil {
+			return nil, fmt.Errorf("shadertoy: %s: %w", rp.Name, err)
+		}
+		p.passes = append(p.passes, pa)
+	}
+	return p, nil
+}
+
+// bufferIndex returns 0 to 3 for Buffer A to D or -1 if the pass is not a buffer.
+func bufferIndex(rp *RenderPass) int {
+	for _, out := range rp.Outputs {
+		for i, id := range bufferIDs {
+			if out.ID == id {
+				return i
+			}
+		}
+	}
+	name := strings.TrimPrefix(rp.Name, "Buffer ")
+	if len(name) == 1 && name[0] >= 'A' && name[0] <= 'D' {
+		return int(name[0] - 'A')
+	}
+	return -1
+}
+
+// newBuffer creates a pair of framebuffers with the most precise color format
+// the context can render to. Shadertoy buffers hold floats.
+func (p *Player) newBuffer() (b *buffer, err error) {
+	for i, format := range p.formats {
+		b, err = newBuffer(p.width, p.height, format)
+		if err == nil {
+			p.formats = p.formats[i : i+1]
+			return b, nil
+		}
+		shaders.ClearErrors()
+	}
+	return nil, err
+}
+
+func newBuffer(width, height int, format shaders.TextureFormat) (*buffer, error) {
+	cfg := shaders.FramebufferConfig{Width: width, Height: height, Color: []shaders.TextureFormat{format}}
+	front, err := shaders.NewFramebuffer(cfg)
+	if err != nil {
+		return nil, err
+	}
+	back, err := shaders.NewFramebuffer(cfg)
+	if err != nil {
+		front.Delete()
+		return nil, err
+	}
+	// Buffers start out cleared to zero.
+	for _, fb := range []*shaders.Framebuffer{front, back} {
+		if err := fb.Clear(color.Transparent); err != nil {
+			front.Delete()
+			back.Delete()
+			return nil, err
+		}
+	}
+	return &buffer{front: front, back: back}, nil
+}
+
+func (p *Player) newPass(rp *RenderPass, common, mediaDir string) (*pass, error) {
+	pa := &pass{name: rp.Name, buffer: -1}
+	if rp.Type == TypeBuffer {
+		pa.buffer = bufferIndex(rp)
+	}
+	for i := range pa.channels {
+		pa.channels[i].buffer = -1
+	}
+	for _, in := range rp.Inputs {
+		if in.Channel < 0 || in.Channel > 3 {
+			return nil, fmt.Errorf("input %s has invalid channel %d", in.ID, in.Channel)
+		}
+		ch, err := p.newChannel(in, mediaDir)
+		if err != nil {
+			return nil, fmt.Errorf("iChannel%d: %w", in.Channel, err)
+		}
+		pa.channels[in.Channel] = ch
+	}
+	code := rp.Code
+	if common != "" {
+		// Compile errors in Common report source string 1, the pass code source string 0.
+		code = "#line 1 1\n" + common + "\n#line 1 0\n" + code
+	}
+	prog, err := shaders.NewFragmentProgram(code + "\x00")
+	if err != nil {
+		return nil, err
+	}
+	pa.prog = prog
+	return pa, nil
+}
+
+func (p *Player) newChannel(in Input, mediaDir string) (channel, error) {
+	ch := channel{buffer: -1, minFilter: gl.LINEAR, magFilter: gl.LINEAR, wrap: gl.CLAMP_TO_EDGE}
+	switch in.Sampler.Filter {
+	case "nearest":
+		ch.minFilter, ch.magFilter = gl.NEAREST, gl.NEAREST
+	case "mipmap":
+		ch.minFilter = gl.LINEAR_MIPMAP_LINEAR
+	}
+	if in.Sampler.Wrap == "repeat" {
+		ch.wrap = gl.REPEAT
+	}
+	var err error
+	switch kind := in.Kind(); kind {
+	case InputBuffer:
+		ch.buffer = p.inputBuffer(in)
+		if ch.buffer < 0 {
+			return ch, fmt.Errorf("input %s reads a buffer the shader lacks", in.ID)
+		}
+	case InputTexture:
+		ch.tex, err = p.loadTexture(in, mediaDir)
+	case InputKeyboard:
+		// 256 key codes by state: pressed, toggled and pressed this frame.
+		ch.tex, err = p.newTexture(256, 3, make([]byte, 4*256*3))
+		ch.minFilter, ch.magFilter = gl.NEAREST, gl.NEAREST
+	case InputVideo, InputMusic, InputWebcam, InputMic, "musicstream":
+		ch.tex, err = p.newTexture(1, 1, []byte{0, 0, 0, 255})
+	default:
+		err = fmt.Errorf("unsupported input type %q", kind)
+	}
+	return ch, err
+}
+
+// inputBuffer returns the index of the buffer a buffer input reads or -1 if the shader lacks it.
+func (p *Player) inputBuffer(in Input) int {
+	idx := -1
+	for i, id := range bufferIDs {
+		if in.ID == id {
+			idx = i
+		}
+	}
+	// Buffers are also referenced by their preview images, /media/previz/buffer00.png to buffer03.png.
+	base := path.Base(in.Path())
+	if idx < 0 && strings.HasPrefix(base, "buffer0") && len(base) > len("buffer0") {
+		if n, err := strconv.Atoi(base[len("buffer0") : len("buffer0")+1]); err == nil && n < 4 {
+			idx = n
+		}
+	}
+	if idx < 0 || p.buffers[idx] == nil {
+		return -1
+	}
+	return idx
+}
+
+// loadTexture loads the image of a texture input from the media directory.
+func (p *Player) loadTexture(in Input, mediaDir string) (shaders.Texture, error) {
+	src := in.Path()
+	if mediaDir == "" {
+		return shaders.Texture{}, fmt.Errorf("texture %s needs Config.MediaDir", src)
+	}
+	urlPath := src
+	if i := strings.Index(urlPath, "://"); i >= 0 {
+		// Strip the scheme and host of absolute URLs.
+		urlPath = urlPath[i+3:]
+		urlPath = urlPath[strings.IndexByte(urlPath, '/')+1:]
+	}
+	candidates := []string{
+		filepath.Join(mediaDir, path.Base(urlPath)),
+		filepath.Join(mediaDir, filepath.FromSlash(strings.TrimPrefix(urlPath, "/"))),
+	}
+	var f *os.File
+	var err error
+	for _, name := range candidates {
+		f, err = os.Open(name)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return shaders.Texture{}, fmt.Errorf("texture %s not found in %s: %w", src, mediaDir, err)
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return shaders.Texture{}, fmt.Errorf("texture %s: %w", src, err)
+	}
+	bounds := img.Bounds()
+	nrgba := image.NewNRGBA(image.Rectangle{Max: bounds.Size()})
+	draw.Draw(nrgba, nrgba.Rect, img, bounds.Min, draw.Src)
+	if in.Sampler.VFlip {
+		// OpenGL stores the first row at the bottom. Shadertoy flips images by
+		// default so they appear upright, unflipped textures appear upside down.
+		flipRows(nrgba)
+	}
+	format := shaders.FormatRGBA8
+	if in.Sampler.SRGB {
+		format.Internal = gl.SRGB8_ALPHA8
+	}
+	tex, err := shaders.NewTexture(nrgba.Rect.Dx(), nrgba.Rect.Dy(), format, nrgba.Pix)
+	if err != nil {
+		return tex, err
+	}
+	p.textures = append(p.textures, tex)
+	if in.Sampler.Filter == "mipmap" {
+		err = tex.GenerateMipmap()
+	}
+	return tex, err
+}
+
+func (p *Player) newTexture(width, height int, data []byte) (shaders.Texture, error) {
+	tex, err := shaders.NewTexture(width, height, shaders.FormatRGBA8, data)
+	if err == nil {
+		p.textures = append(p.textures, tex)
+	}
+	return tex, err
+}
+
+// Render draws a frame: the buffer passes to their buffers and the image pass to
+// the current framebuffer. u holds the time, frame, mouse and date of the frame,
+// its Width and Height are ignored in favor of the player's size, and is usually
+// the Uniforms of an app.App. If u is nil the frame is rendered at time zero.
+func (p *Player) Render(r *shaders.Renderer, u *shaders.StandardUniforms) error {
+	var uniforms shaders.StandardUniforms
+	if u != nil {
+		uniforms = *u
+	}
+	uniforms.Width, uniforms.Height = float32(p.width), float32(p.height)
+	for _, pa := range p.passes {
+		var target *buffer
+		if pa.buffer >= 0 {
+			target = p.buffers[pa.buffer]
+			target.back.Bind()
+		}
+		err := p.draw(r, pa, &uniforms)
+		if target != nil {
+			target.back.Unbind()
+			target.front, target.back = target.back, target.front
+		}
+		if err != nil {
+			return fmt.Errorf("shadertoy: %s: %w", pa.name, err)
+		}
+	}
+	return nil
+}
+
+func (p *Player) draw(r *shaders.Renderer, pa *pass, u *shaders.StandardUniforms) error {
+	pa.prog.Bind()
+	if err := u.Apply(pa.prog); err != nil {
+		return err
+	}
+	for i, ch := range pa.channels {
+		tex := ch.tex
+		if ch.buffer >= 0 {
+			tex = p.buffers[ch.buffer].front.Color(0)
+		}
+		if tex == (shaders.Texture{}) {
+			continue
+		}
+		tex.BindUnit(i)
+		if ch.buffer >= 0 && ch.minFilter == gl.LINEAR_MIPMAP_LINEAR {
+			if err := tex.GenerateMipmap(); err != nil {
+				return err
+			}
+		}
+		if err := tex.SetFilter(ch.minFilter, ch.magFilter); err != nil {
+			return err
+		}
+		if err := tex.SetWrap(ch.wrap, ch.wrap); err != nil {
+			return err
+		}
+		w, h := tex.Size()
+		err := pa.prog.SetUniformName3f("iChannelResolution["+strconv.Itoa(i)+"]\x00", float32(w), float32(h), 1)
+		if err := shaders.IgnoreNoUniform(err); err != nil {
+			return err
+		}
+		err = pa.prog.SetUniformName1f("iChannelTime["+strconv.Itoa(i)+"]\x00", u.Time)
+		if err := shaders.IgnoreNoUniform(err); err != nil {
+			return err
+		}
+	}
+	return r.DrawFullscreen(pa.prog)
+}
+
+// Size returns the size of the rendered image in pixels.
+func (p *Player) Size() (width, height int) { return p.width, p.height }
+
+// Buffer returns the latest frame of Buffer A to D, index 0 to 3. It returns
+// nil if the shader lacks the buffer or i is out of range.
+func (p *Player) Buffer(i int) *shaders.Framebuffer {
+	if i < 0 || i >= len(p.buffers) || p.buffers[i] == nil {
+		return nil
+	}
+	return p.buffers[i].front
+}
+
+// Delete deletes the programs, buffers and textures of the player.
+func (p *Player) Delete() {
+	for _, pa := range p.passes {
+		pa.prog.Delete()
+	}
+	for _, b := range p.buffers {
+		if b != nil {
+			b.front.Delete()
+			b.back.Delete()
+		}
+	}
+	for _, tex := range p.textures {
+		tex.Delete()
+	}
+	*p = Player{}
+}
+
+// flipRows flips img upside down.
+func flipRows(img *image.NRGBA) {
+	h := img.Rect.Dy()
+	row := make([]byte, img.Stride)
+	for y := 0; y < h/2; y++ {
+		top := img.Pix[y*img.Stride : (y+1)*img.Stride]
+		bottom := img.Pix[(h-1-y)*img.Stride : (h-y)*img.Stride]
+		copy(row, top)
+		copy(top, bottom)
+		copy(bottom, row)
+	}
+}
@@ -0,0 +1,159 @@
+package shadertoy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/soypat/shaders"
+	"github.com/soypat/shaders/backend/fake"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// colorTarget returns the texture the draw rendered to.
+func colorTarget(b *fake.Backend, d fake.Draw) uint32 {
+	return b.Framebuffer(d.Framebuffer).Attachments[gl.COLOR_ATTACHMENT0].Texture
+}
+
+// passCode returns the code of a pass declaring the uniform name so its draws can be told apart.
+func passCode(name string) string {
+	return "uniform float " + name + "; void mainImage(out vec4 fragColor, in vec2 fragCoord) { fragColor = texture(iChannel0, fragCoord) + " + name + "; }"
+}
+
+func TestPlayerRender(t *testing.T) {
+	b := fake.New(16, 16)
+	shaders.SetBackend(b)
+	// Tabs are out of order, Buffer B reads Buffer A, and each buffer reads its previous frame.
+	src := `{"renderpass":[
+		{"name":"Image","type":"image","code":"` + passCode("image") + `","inputs":[
+			{"id":"XsXGR8","type":"buffer","channel":0,"sampler":{"filter":"mipmap","wrap":"clamp"}},
+			{"id":"4dXGR8","type":"buffer","channel":2,"sampler":{"filter":"linear","wrap":"clamp"}}
+		]},
+		{"name":"Buffer B","type":"buffer","code":"` + passCode("bufferB") + `","outputs":[{"id":"XsXGR8","channel":0}],"inputs":[
+			{"id":"4dXGR8","type":"buffer","channel":0,"sampler":{"filter":"linear","wrap":"clamp"}},
+			{"id":"XsXGR8","type":"buffer","channel":1,"sampler":{"filter":"linear","wrap":"clamp"}}
+		]},
+		{"name":"Buffer A","type":"buffer","code":"` + passCode("bufferA") + `","outputs":[{"id":"4dXGR8","channel":0}],"inputs":[
+			{"id":"4dXGR8","type":"buffer","channel":0,"sampler":{"filter":"nearest","wrap":"repeat"}}
+		]}
+	]}`
+	s, err := Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewPlayer(s, Config{Width: 4, Height: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Delete()
+	r := shaders.NewRenderer()
+	render := func(u *shaders.StandardUniforms) []fake.Draw {
+		t.Helper()
+		n := len(b.Draws)
+		if err := p.Render(r, u); err != nil {
+			t.Fatal(err)
+		}
+		draws := b.Draws[n:]
+		if len(draws) != 3 {
+			t.Fatalf("got %d draws, want one per pass", len(draws))
+		}
+		// Buffers render in alphabetical order and then the image.
+		for i, name := range []string{"bufferA", "bufferB", "image"} {
+			if _, ok := b.Program(draws[i].Program).Uniforms[name]; !ok {
+				t.Fatalf("draw %d is not of the %s pass", i, name)
+			}
+		}
+		return draws
+	}
+
+	frame1 := render(nil)
+	a1, b1 := colorTarget(b, frame1[0]), colorTarget(b, frame1[1])
+	if frame1[0].Viewport != [4]int32{0, 0, 4, 2} || frame1[2].Framebuffer != 0 {
+		t.Errorf("got buffer viewport %v and image framebuffer %d", frame1[0].Viewport, frame1[2].Framebuffer)
+	}
+	if got := frame1[0].Textures[0]; got == a1 {
+		t.Error("Buffer A read the buffer it renders to")
+	}
+	if got := frame1[1].Textures; got[0] != a1 || got[1] == b1 {
+		t.Errorf("Buffer B read %d and %d, want this frame's Buffer A %d and not %d", got[0], got[1], a1, b1)
+	}
+	if got := frame1[2].Textures; got[0] != b1 || got[2] != a1 {
+		t.Errorf("Image read %d and %d, want latest buffers %d and %d", got[0], got[2], b1, a1)
+	}
+
+	frame2 := render(&shaders.StandardUniforms{Time: 1, Frame: 1})
+	a2, b2 := colorTarget(b, frame2[0]), colorTarget(b, frame2[1])
+	if a2 == a1 || b2 == b1 {
+		t.Error("buffers rendered to the same framebuffers two frames in a row")
+	}
+	// A pass reading its own buffer reads its previous frame.
+	if got := frame2[0].Textures[0]; got != a1 {
+		t.Errorf("Buffer A read %d, want its previous frame %d", got, a1)
+	}
+	if got := frame2[1].Textures; got[0] != a2 || got[1] != b1 {
+		t.Errorf("Buffer B read %d and %d, want %d and its previous frame %d", got[0], got[1], a2, b1)
+	}
+	if got := frame2[2].Textures; got[0] != b2 || got[2] != a2 {
+		t.Errorf("Image read %d and %d, want latest buffers %d and %d", got[0], got[2], b2, a2)
+	}
+	if p.Buffer(0).Color(0) == (shaders.Texture{}) || p.Buffer(1) == nil || p.Buffer(2) != nil {
+		t.Error("Buffer returned the wrong framebuffers")
+	}
+
+	// Textures keep the sampler state of the last channel reading them.
+	samplers := []struct {
+		name     string
+		tex      uint32
+		min, mag int32
+		wrap     int32
+	}{
+		{"Buffer A read by itself", a1, gl.NEAREST, gl.NEAREST, gl.REPEAT},
+		{"Buffer A read by the image", a2, gl.LINEAR, gl.LINEAR, gl.CLAMP_TO_EDGE},
+		{"Buffer B read with mipmaps", b2, gl.LINEAR_MIPMAP_LINEAR, gl.LINEAR, gl.CLAMP_TO_EDGE},
+	}
+	for _, sampler := range samplers {
+		params := b.Texture(sampler.tex).Params
+		if params[gl.TEXTURE_MIN_FILTER] != sampler.min || params[gl.TEXTURE_MAG_FILTER] != sampler.mag ||
+			params[gl.TEXTURE_WRAP_S] != sampler.wrap || params[gl.TEXTURE_WRAP_T] != sampler.wrap {
+			t.Errorf("%s: got parameters %v", sampler.name, params)
+		}
+	}
+	if got := b.Program(frame2[2].Program).Uniform("iTime"); len(got) != 1 || got[0] != 1 {
+		t.Errorf("iTime: got %v, want [1]", got)
+	}
+}
+
+func TestNewPlayerErrors(t *testing.T) {
+	b := fake.New(8, 8)
+	shaders.SetBackend(b)
+	const code = "void mainImage(out vec4 fragColor, in vec2 fragCoord) { fragColor = vec4(1.0); }"
+	tests := []struct {
+		name string
+		s    Shader
+		cfg  Config
+	}{
+		{"no image pass", Shader{RenderPass: []RenderPass{{Name: "Buffer A", Type: TypeBuffer, Code: code}}}, Config{Width: 4, Height: 4}},
+		{"no passes", Shader{}, Config{Width: 4, Height: 4}},
+		{"no size", Shader{RenderPass: []RenderPass{{Name: "Image", Type: TypeImage, Code: code}}}, Config{}},
+		{"unknown buffer", Shader{RenderPass: []RenderPass{{Name: "Buffer E", Type: TypeBuffer, Code: code}, {Name: "Image", Type: TypeImage, Code: code}}}, Config{Width: 4, Height: 4}},
+	}
+	for _, test := range tests {
+		before := b.Objects()
+		if _, err := NewPlayer(&test.s, test.cfg); err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+		if b.Objects() != before {
+			t.Errorf("%s: %d objects leaked", test.name, b.Objects()-before)
+		}
+	}
+
+	p, err := NewPlayer(&Shader{RenderPass: []RenderPass{{Name: "Image", Type: TypeImage, Code: code}}}, Config{Width: 4, Height: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Delete()
+	for _, i := range []int{-1, 0, 3, 4} {
+		if fb := p.Buffer(i); fb != nil {
+			t.Errorf("Buffer(%d): got %v for a shader with no buffers", i, fb)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+// Package shadertoy imports shaders exported from Shadertoy as JSON and renders
+// them, including their Common code and Buffer A to D passes which may read
+// their own output of the previous frame.
+//
+//	s, err := shadertoy.Load("shader.json")
+//	if err != nil {
+//		return err
+//	}
+//	player, err := shadertoy.NewPlayer(s, shadertoy.Config{Width: 800, Height: 450, MediaDir: "media"})
+//	if err != nil {
+//		return err
+//	}
+//	defer player.Delete()
+//	err = a.Run(nil, func(f app.Frame) error {
+//		return player.Render(a.Renderer, &a.Uniforms)
+//	})
+//
+// Textures are looked up in a local directory instead of being downloaded
+// from shadertoy.com so shaders run offline.
+package shadertoy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+)
+
+// Pass types.
+const (
+	TypeImage  = "image"
+	TypeBuffer = "buffer"
+	TypeCommon = "common"
+	TypeSound  = "sound"
+	TypeCube   = "cubemap"
+)
+
+// Input types.
+const (
+	InputTexture  = "texture"
+	InputBuffer   = "buffer"
+	InputKeyboard = "keyboard"
+	InputCubemap  = "cubemap"
+	InputVolume   = "volume"
+	InputVideo    = "video"
+	InputMusic    = "music"
+	InputWebcam   = "webcam"
+	InputMic      = "mic"
+)
+
+// Shader is a Shadertoy shader as exported by the site and its API.
+type Shader struct {
+	Version    string       `json:"ver"`
+	Info       Info         `json:"info"`
+	RenderPass []RenderPass `json:"renderpass"`
+}
+
+// Info describes a shader.
+type Info struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Username    string   `json:"username"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+}
+
+// RenderPass is a tab of the Shadertoy editor.
+type RenderPass struct {
+	// Name is the tab name, i.e: "Image", "Common", "Buffer A".
+	Name string `json:"name"`
+	// Type is one of TypeImage, TypeBuffer, TypeCommon, TypeSound or TypeCube.
+	Type        string   `json:"type"`
+	Description string   `json:"description"`
+	Code        string   `json:"code"`
+	Inputs      []Input  `json:"inputs"`
+	Outputs     []Output `json:"outputs"`
+}
+
+// Input is a texture bound to one of the iChannel0 to iChannel3 samplers of a pass.
+type Input struct {
+	// ID identifies the input. Buffer inputs have the ID of the buffer's output.
+	ID ID `json:"id"`
+	// Src is the media path, i.e: "/media/a/<hash>.png". Older exports name it filepath.
+	Src      string `json:"src"`
+	Filepath string `json:"filepath"`
+	// CType is one of the Input* constants. Older exports name it type.
+	CType   string  `json:"ctype"`
+	Type    string  `json:"type"`
+	Channel int     `json:"channel"`
+	Sampler Sampler `json:"sampler"`
+}
+
+// Kind returns the input type, one of the Input* constants.
+func (in Input) Kind() string {
+	if in.CType != "" {
+		return in.CType
+	}
+	return in.Type
+}
+
+// Path returns the media path of the input.
+func (in Input) Path() string {
+	if in.Src != "" {
+		return in.Src
+	}
+	return in.Filepath
+}
+
+// Sampler holds how an input is sampled.
+type Sampler struct {
+	// Filter is "nearest", "linear" or "mipmap".
+	Filter string `json:"filter"`
+	// Wrap is "clamp" or "repeat".
+	Wrap string `json:"wrap"`
+	// VFlip flips textures so their first row is at the top, as images are displayed.
+	VFlip Bool `json:"vflip"`
+	SRGB  Bool `json:"srgb"`
+	// Internal is the texel format, i.e: "byte".
+	Internal string `json:"internal"`
+}
+
+// Output is the target of a pass.
+type Output struct {
+	ID      ID  `json:"id"`
+	Channel int `json:"channel"`
+}
+
+// ID is a Shadertoy identifier, exported as a string or, by older exports, a number.
+type ID string
+
+// UnmarshalJSON accepts strings and numbers.
+func (id *ID) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*id = ID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	*id = ID(n.String())
+	return nil
+}
+
+// Bool is a boolean exported as true or false or as the strings "true" and "false".
+type Bool bool
+
+// UnmarshalJSON accepts booleans and strings.
+func (v *Bool) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		parsed, err := strconv.ParseBool(s)
+		*v = Bool(parsed)
+		return err
+	}
+	var parsed bool
+	err := json.Unmarshal(b, &parsed)
+	*v = Bool(parsed)
+	return err
+}
+
+// Parse reads a shader exported as JSON. It accepts the shader object, the
+// API response {"Shader": {...}} and an array with a single shader.
+func Parse(r io.Reader) (*Shader, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '[' {
+		var shaders []Shader
+		if err := json.Unmarshal(b, &shaders); err != nil {
+			return nil, err
+		}
+		if len(shaders) != 1 {
+			return nil, errors.New("shadertoy: expected a single shader, got " + strconv.Itoa(len(shaders)))
+		}
+		return validate(&shaders[0])
+	}
+	var response struct{ Shader *Shader }
+	if err := json.Unmarshal(b, &response); err != nil {
+		return nil, err
+	}
+	if response.Shader != nil {
+		return validate(response.Shader)
+	}
+	var shader Shader
+	if err := json.Unmarshal(b, &shader); err != nil {
+		return nil, err
+	}
+	return validate(&shader)
+}
+
+// Load reads a shader from a JSON file, see Parse.
+func Load(name string) (*Shader, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+func validate(s *Shader) (*Shader, error) {
+	if s.Pass(TypeImage) == nil {
+		return nil, errors.New("shadertoy: shader has no image pass")
+	}
+	return s, nil
+}
+
+// Pass returns the first render pass of the given type or nil if there is none.
+func (s *Shader) Pass(passType string) *RenderPass {
+	for i := range s.RenderPass {
+		if s.RenderPass[i].Type == passType {
+			return &s.RenderPass[i]
+		}
+	}
+	return nil
+}
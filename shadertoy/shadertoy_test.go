@@ -0,0 +1,114 @@
+package shadertoy
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const imagePass = `{"info":{"id":"abc123","name":"test"},"renderpass":[
+	{"name":"Buffer A","type":"buffer","code":"a","inputs":[{"id":257,"ctype":"buffer","channel":0,"sampler":{"vflip":"true","srgb":false}}],"outputs":[{"id":"4dXGR8","channel":0}]},
+	{"name":"Image","type":"image","code":"b","inputs":[{"id":"4dXGR8","type":"buffer","channel":1,"sampler":{"vflip":true,"srgb":"false"}}]}
+]}`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		wantErr bool
+	}{
+		{name: "object", src: imagePass},
+		{name: "response", src: `{"Shader":` + imagePass + `}`},
+		{name: "array", src: "\n[" + imagePass + "]\n"},
+		{name: "array of two", src: "[" + imagePass + "," + imagePass + "]", wantErr: true},
+		{name: "empty array", src: "[]", wantErr: true},
+		{name: "no image pass", src: `{"renderpass":[{"name":"Common","type":"common","code":"c"}]}`, wantErr: true},
+		{name: "malformed", src: `{"renderpass":`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := Parse(strings.NewReader(test.src))
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.Info.ID != "abc123" || len(s.RenderPass) != 2 {
+				t.Fatalf("got info %+v with %d passes", s.Info, len(s.RenderPass))
+			}
+			buf, img := s.Pass(TypeBuffer), s.Pass(TypeImage)
+			if buf == nil || img == nil || img.Code != "b" {
+				t.Fatalf("got buffer pass %v and image pass %v", buf, img)
+			}
+			if in := buf.Inputs[0]; in.ID != "257" || in.Kind() != InputBuffer || !in.Sampler.VFlip || in.Sampler.SRGB {
+				t.Errorf("buffer input: got %+v", in)
+			}
+			if in := img.Inputs[0]; in.ID != "4dXGR8" || in.Kind() != InputBuffer || in.Channel != 1 || !in.Sampler.VFlip || in.Sampler.SRGB {
+				t.Errorf("image input: got %+v", in)
+			}
+			if bufferIndex(buf) != 0 {
+				t.Errorf("buffer index: got %d, want 0", bufferIndex(buf))
+			}
+		})
+	}
+}
+
+func TestIDUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		src     string
+		want    ID
+		wantErr bool
+	}{
+		{src: `"4dXGR8"`, want: "4dXGR8"},
+		{src: `""`, want: ""},
+		{src: `257`, want: "257"},
+		{src: `1.5`, want: "1.5"},
+		{src: `true`, wantErr: true},
+		{src: `{}`, wantErr: true},
+	}
+	for _, test := range tests {
+		var id ID
+		err := json.Unmarshal([]byte(test.src), &id)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected error, got %q", test.src, id)
+			}
+			continue
+		}
+		if err != nil || id != test.want {
+			t.Errorf("%s: got %q, %v; want %q", test.src, id, err, test.want)
+		}
+	}
+}
+
+func TestBoolUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		src     string
+		want    Bool
+		wantErr bool
+	}{
+		{src: `true`, want: true},
+		{src: `false`, want: false},
+		{src: `"true"`, want: true},
+		{src: `"false"`, want: false},
+		{src: `"yes"`, wantErr: true},
+		{src: `1`, wantErr: true},
+	}
+	for _, test := range tests {
+		var v Bool
+		err := json.Unmarshal([]byte(test.src), &v)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected error, got %v", test.src, v)
+			}
+			continue
+		}
+		if err != nil || v != test.want {
+			t.Errorf("%s: got %v, %v; want %v", test.src, v, err, test.want)
+		}
+	}
+}
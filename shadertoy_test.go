@@ -0,0 +1,51 @@
+package shaders
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsShadertoy(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want bool
+	}{
+		{"shadertoy default", "void mainImage( out vec4 fragColor, in vec2 fragCoord )\n{\n}\n", true},
+		{"no spaces", "void mainImage(out vec4 c,in vec2 p){}", true},
+		{"tabs and newlines", "void\n\tmainImage\n\t(\n\tout vec4 c, in vec2 p) {}", true},
+		{"forward declaration", "void mainImage(out vec4, in vec2);\nvoid mainImage(out vec4 c, in vec2 p) {}", true},
+		{"precision qualifiers", "void mainImage(out highp vec4 c, in mediump vec2 p) {}", true},
+		{"main in line comment", "// void main() {}\nvoid mainImage(out vec4 c, in vec2 p) {}", true},
+		{"main in block comment", "/* void main()\n{} */\nvoid mainImage(out vec4 c, in vec2 p) {}", true},
+		{"main", "void main() { gl_FragColor = vec4(1.0); }", false},
+		{"main calling mainImage", "void mainImage(out vec4 c, in vec2 p) {}\nvoid main() { mainImage(c, p); }", false},
+		{"mainImage in comment", "// void mainImage(out vec4 c, in vec2 p)\nvoid main() {}", false},
+		{"longer identifier", "void mainImageVR(out vec4 c, in vec2 p, vec3 o, vec3 d) {}", false},
+		{"not a definition", "vec4 mainImage(vec2 p) { return vec4(p, 0.0, 1.0); }", false},
+	}
+	for _, test := range tests {
+		if got := isShadertoy(test.src + "\x00"); got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestWrapShadertoy(t *testing.T) {
+	const src = "void mainImage(out vec4 c, in vec2 p) {\n\tc = vec4(p, 0.0, 1.0);\n}\n"
+	got := wrapShadertoy(src + "\x00")
+	if !strings.HasPrefix(got, "#version 300 es\n") {
+		t.Errorf("directive is not on the first line:\n%s", got)
+	}
+	// The #line directive numbers the first line of the Shadertoy code 1.
+	if !strings.Contains(got, "\n#line 1\n"+src) {
+		t.Errorf("#line 1 does not directly precede the source:\n%s", got)
+	}
+	wantEnd := src + "\nvoid main() {\n\tmainImage(shaders_FragColor, gl_FragCoord.xy);\n}\n\x00"
+	if !strings.HasSuffix(got, wantEnd) {
+		t.Errorf("got ending\n%q\nwant\n%q", got[len(got)-len(wantEnd):], wantEnd)
+	}
+	if strings.Count(got, "\x00") != 1 {
+		t.Error("source has more than one null terminator")
+	}
+}
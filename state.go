@@ -0,0 +1,248 @@
+package shaders
+
+import (
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// MaxColorAttachments is the number of color attachments
+// for which a PipelineState holds blend state.
+const MaxColorAttachments = 8
+
+// PipelineState is the fixed function state applied by a Renderer before
+// drawing. It is a plain comparable value: modifying a copy has no effect
+// on the Renderer until it is passed to Renderer.SetState.
+//
+//	state := shaders.DefaultPipelineState
+//	state.Depth.Test = true
+//	renderer.SetState(state)
+type PipelineState struct {
+	// Blend holds blending configuration for each color attachment. Differing
+	// configurations require OpenGL 4.0, OpenGL ES 3.2 or an extension, draws
+	// return ErrUnsupported otherwise.
+	Blend   [MaxColorAttachments]BlendState
+	Depth   DepthState
+	Stencil StencilState
+	Raster  RasterState
+	Scissor ScissorState
+	// ColorMask enables writing of the red, green, blue and alpha components.
+	ColorMask [4]bool
+}
+
+// BlendState configures how fragment shader outputs are combined with the
+// values already in a color attachment.
+type BlendState struct {
+	Enabled bool
+	// EquationRGB and EquationAlpha combine the source and destination terms, i.e: gl.FUNC_ADD, gl.MAX.
+	EquationRGB, EquationAlpha uint32
+	// Factors multiplying the source and destination, i.e: gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA.
+	SrcRGB, DstRGB     uint32
+	SrcAlpha, DstAlpha uint32
+}
+
+// DepthState configures the depth test.
+type DepthState struct {
+	// Test enables the depth test. When disabled the depth buffer is not written either.
+	Test bool
+	// Write enables writing to the depth buffer.
+	Write bool
+	// Func is the comparison with which fragments pass the test, i.e: gl.LESS, gl.LEQUAL.
+	Func uint32
+}
+
+// StencilState configures the stencil test for front and back facing polygons.
+type StencilState struct {
+	Test  bool
+	Front StencilFace
+	Back  StencilFace
+}
+
+// StencilFace is the stencil test configuration for one polygon face.
+type StencilFace struct {
+	// Func compares Ref&ReadMask with the stored stencil value&ReadMask, i.e: gl.ALWAYS, gl.EQUAL.
+	Func     uint32
+	Ref      int32
+	ReadMask uint32
+	// WriteMask selects the bits of the stencil buffer that are written.
+	WriteMask uint32
+	// Actions taken when stencil test fails, when stencil test passes
+	// but depth test fails and when both pass, i.e: gl.KEEP, gl.REPLACE, gl.INCR.
+	Fail, DepthFail, Pass uint32
+}
+
+// RasterState configures how polygons are rasterized.
+type RasterState struct {
+	// CullFace enables face culling of the CullMode faces, i.e: gl.BACK.
+	CullFace bool
+	CullMode uint32
+	// FrontFace is the winding of front facing polygons, i.e: gl.CCW.
+	FrontFace uint32
+	// PolygonMode is how polygons are drawn, i.e: gl.FILL, gl.LINE for wireframes.
+	// OpenGL ES only supports gl.FILL, draws return ErrUnsupported otherwise.
+	PolygonMode uint32
+}
+
+// ScissorState discards fragments outside of a window coordinate rectangle.
+type ScissorState struct {
+	Test bool
+	// X and Y are the lower left corner of the scissor box.
+	X, Y, Width, Height int32
+}
+
+var defaultStencilFace = StencilFace{
+	Func: gl.ALWAYS, ReadMask: 0xffffffff, WriteMask: 0xffffffff,
+	Fail: gl.KEEP, DepthFail: gl.KEEP, Pass: gl.KEEP,
+}
+
+var (
+	// BlendDisabled is the initial OpenGL blend state.
+	BlendDisabled = BlendState{
+		EquationRGB: gl.FUNC_ADD, EquationAlpha: gl.FUNC_ADD,
+		SrcRGB: gl.ONE, DstRGB: gl.ZERO, SrcAlpha: gl.ONE, DstAlpha: gl.ZERO,
+	}
+	// BlendAlpha is conventional transparency with straight alpha.
+	BlendAlpha = BlendState{
+		Enabled:     true,
+		EquationRGB: gl.FUNC_ADD, EquationAlpha: gl.FUNC_ADD,
+		SrcRGB: gl.SRC_ALPHA, DstRGB: gl.ONE_MINUS_SRC_ALPHA, SrcAlpha: gl.ONE, DstAlpha: gl.ONE_MINUS_SRC_ALPHA,
+	}
+	// BlendPremultiplied is transparency with premultiplied alpha.
+	BlendPremultiplied = BlendState{
+		Enabled:     true,
+		EquationRGB: gl.FUNC_ADD, EquationAlpha: gl.FUNC_ADD,
+		SrcRGB: gl.ONE, DstRGB: gl.ONE_MINUS_SRC_ALPHA, SrcAlpha: gl.ONE, DstAlpha: gl.ONE_MINUS_SRC_ALPHA,
+	}
+	// BlendAdditive adds the fragment color to the destination, used for glows and particles.
+	BlendAdditive = BlendState{
+		Enabled:     true,
+		EquationRGB: gl.FUNC_ADD, EquationAlpha: gl.FUNC_ADD,
+		SrcRGB: gl.SRC_ALPHA, DstRGB: gl.ONE, SrcAlpha: gl.ONE, DstAlpha: gl.ONE,
+	}
+
+	// DefaultPipelineState is the initial OpenGL state of a context.
+	DefaultPipelineState = PipelineState{
+		Blend: [MaxColorAttachments]BlendState{
+			BlendDisabled, BlendDisabled, BlendDisabled, BlendDisabled,
+			BlendDisabled, BlendDisabled, BlendDisabled, BlendDisabled,
+		},
+		Depth:     DepthState{Write: true, Func: gl.LESS},
+		Stencil:   StencilState{Front: defaultStencilFace, Back: defaultStencilFace},
+		Raster:    RasterState{CullMode: gl.BACK, FrontFace: gl.CCW, PolygonMode: gl.FILL},
+		ColorMask: [4]bool{true, true, true, true},
+	}
+)
+
+// WithBlend returns a copy of ps with the blend state of all color attachments set to bs.
+func (ps PipelineState) WithBlend(bs BlendState) PipelineState {
+	for i := range ps.Blend {
+		ps.Blend[i] = bs
+	}
+	return ps
+}
+
+// SetState sets the pipeline state used by subsequent draws and clears.
+// Only the state that differs from what was last sent to OpenGL is applied.
+func (r *Renderer) SetState(ps PipelineState) {
+	r.state = ps
+}
+
+// State returns the pipeline state used by draws.
+func (r *Renderer) State() PipelineState { return r.state }
+
+// InvalidateState forces the whole pipeline state to be sent to OpenGL on the
+// next draw. Call it after modifying OpenGL state outside of the Renderer.
+func (r *Renderer) InvalidateState() {
+	r.appliedValid = false
+}
+
+// applyState sends the difference between the requested state and
+// the shadow copy of the state last applied to OpenGL.
+func (r *Renderer) applyState() error {
+	s, old := &r.state, &r.applied
+	if r.es && s.Raster.PolygonMode != gl.FILL {
+		return ErrUnsupported
+	}
+	if !r.indexedBlend && !uniformBlend(&s.Blend) {
+		return ErrUnsupported
+	}
+	force := !r.appliedValid
+	if force || s.Blend != old.Blend {
+		applyBlend(&s.Blend, &old.Blend, force)
+	}
+	if force || s.Depth != old.Depth {
+		setCapability(gl.DEPTH_TEST, s.Depth.Test)
+		gl.DepthMask(s.Depth.Write)
+		gl.DepthFunc(s.Depth.Func)
+	}
+	if force || s.Stencil != old.Stencil {
+		setCapability(gl.STENCIL_TEST, s.Stencil.Test)
+		applyStencilFace(gl.FRONT, s.Stencil.Front)
+		applyStencilFace(gl.BACK, s.Stencil.Back)
+	}
+	if force || s.Raster != old.Raster {
+		setCapability(gl.CULL_FACE, s.Raster.CullFace)
+		gl.CullFace(s.Raster.CullMode)
+		gl.FrontFace(s.Raster.FrontFace)
+		if !r.es {
+			gl.PolygonMode(gl.FRONT_AND_BACK, s.Raster.PolygonMode)
+		}
+	}
+	if force || s.Scissor != old.Scissor {
+		setCapability(gl.SCISSOR_TEST, s.Scissor.Test)
+		gl.Scissor(s.Scissor.X, s.Scissor.Y, s.Scissor.Width, s.Scissor.Height)
+	}
+	if force || s.ColorMask != old.ColorMask {
+		m := s.ColorMask
+		gl.ColorMask(m[0], m[1], m[2], m[3])
+	}
+	r.applied = *s
+	r.appliedValid = true
+	return glCheckError()
+}
+
+func applyBlend(blend, old *[MaxColorAttachments]BlendState, force bool) {
+	if uniformBlend(blend) {
+		// Non-indexed calls set the state of all draw buffers at once.
+		b := blend[0]
+		setCapability(gl.BLEND, b.Enabled)
+		gl.BlendEquationSeparate(b.EquationRGB, b.EquationAlpha)
+		gl.BlendFuncSeparate(b.SrcRGB, b.DstRGB, b.SrcAlpha, b.DstAlpha)
+		return
+	}
+	for i, b := range blend {
+		if !force && b == old[i] {
+			continue
+		}
+		buf := uint32(i)
+		if b.Enabled {
+			gl.Enablei(gl.BLEND, buf)
+		} else {
+			gl.Disablei(gl.BLEND, buf)
+		}
+		gl.BlendEquationSeparatei(buf, b.EquationRGB, b.EquationAlpha)
+		gl.BlendFuncSeparatei(buf, b.SrcRGB, b.DstRGB, b.SrcAlpha, b.DstAlpha)
+	}
+}
+
+// uniformBlend reports whether all color attachments share the same blend state.
+func uniformBlend(blend *[MaxColorAttachments]BlendState) bool {
+	for i := range blend {
+		if blend[i] != blend[0] {
+			return false
+		}
+	}
+	return true
+}
+
+func applyStencilFace(face uint32, sf StencilFace) {
+	gl.StencilFuncSeparate(face, sf.Func, sf.Ref, sf.ReadMask)
+	gl.StencilOpSeparate(face, sf.Fail, sf.DepthFail, sf.Pass)
+	gl.StencilMaskSeparate(face, sf.WriteMask)
+}
+
+func setCapability(capability uint32, enabled bool) {
+	if enabled {
+		gl.Enable(capability)
+	} else {
+		gl.Disable(capability)
+	}
+}
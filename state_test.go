@@ -0,0 +1,179 @@
+package shaders
+
+import (
+	"testing"
+
+	"github.com/soypat/shaders/backend/fake"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// stateCalls records the names of the state setting calls sent to the fake backend.
+type stateCalls struct {
+	*fake.Backend
+	calls []string
+}
+
+func (b *stateCalls) record(name string) { b.calls = append(b.calls, name) }
+
+func (b *stateCalls) Enable(c uint32)  { b.record("Enable"); b.Backend.Enable(c) }
+func (b *stateCalls) Disable(c uint32) { b.record("Disable"); b.Backend.Disable(c) }
+func (b *stateCalls) Enablei(c, i uint32) {
+	b.record("Enablei")
+	b.Backend.Enablei(c, i)
+}
+func (b *stateCalls) Disablei(c, i uint32) {
+	b.record("Disablei")
+	b.Backend.Disablei(c, i)
+}
+func (b *stateCalls) BlendEquationSeparate(rgb, alpha uint32) {
+	b.record("BlendEquationSeparate")
+	b.Backend.BlendEquationSeparate(rgb, alpha)
+}
+func (b *stateCalls) BlendEquationSeparatei(buf, rgb, alpha uint32) {
+	b.record("BlendEquationSeparatei")
+	b.Backend.BlendEquationSeparatei(buf, rgb, alpha)
+}
+func (b *stateCalls) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha uint32) {
+	b.record("BlendFuncSeparate")
+	b.Backend.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
+}
+func (b *stateCalls) BlendFuncSeparatei(buf, srcRGB, dstRGB, srcAlpha, dstAlpha uint32) {
+	b.record("BlendFuncSeparatei")
+	b.Backend.BlendFuncSeparatei(buf, srcRGB, dstRGB, srcAlpha, dstAlpha)
+}
+func (b *stateCalls) DepthMask(flag bool) { b.record("DepthMask"); b.Backend.DepthMask(flag) }
+func (b *stateCalls) DepthFunc(fn uint32) { b.record("DepthFunc"); b.Backend.DepthFunc(fn) }
+func (b *stateCalls) StencilFuncSeparate(face, fn uint32, ref int32, mask uint32) {
+	b.record("StencilFuncSeparate")
+	b.Backend.StencilFuncSeparate(face, fn, ref, mask)
+}
+func (b *stateCalls) StencilOpSeparate(face, fail, zfail, zpass uint32) {
+	b.record("StencilOpSeparate")
+	b.Backend.StencilOpSeparate(face, fail, zfail, zpass)
+}
+func (b *stateCalls) StencilMaskSeparate(face, mask uint32) {
+	b.record("StencilMaskSeparate")
+	b.Backend.StencilMaskSeparate(face, mask)
+}
+func (b *stateCalls) CullFace(mode uint32)  { b.record("CullFace"); b.Backend.CullFace(mode) }
+func (b *stateCalls) FrontFace(mode uint32) { b.record("FrontFace"); b.Backend.FrontFace(mode) }
+func (b *stateCalls) PolygonMode(face, mode uint32) {
+	b.record("PolygonMode")
+	b.Backend.PolygonMode(face, mode)
+}
+func (b *stateCalls) Scissor(x, y, width, height int32) {
+	b.record("Scissor")
+	b.Backend.Scissor(x, y, width, height)
+}
+func (b *stateCalls) ColorMask(r, g, bl, a bool) {
+	b.record("ColorMask")
+	b.Backend.ColorMask(r, g, bl, a)
+}
+
+// newStateCalls sets a fake backend emulating an OpenGL or OpenGL ES major.minor
+// context that records state calls.
+func newStateCalls(t *testing.T, major, minor int, es bool) *stateCalls {
+	t.Helper()
+	b := &stateCalls{Backend: newFakeVersion(t, major, minor, es)}
+	SetBackend(b)
+	return b
+}
+
+func TestApplyStateChanges(t *testing.T) {
+	modify := func(f func(*PipelineState)) PipelineState {
+		ps := DefaultPipelineState
+		f(&ps)
+		return ps
+	}
+	perAttachment := DefaultPipelineState
+	perAttachment.Blend[1] = BlendAdditive
+	tests := []struct {
+		name  string
+		state PipelineState
+		want  []string
+	}{
+		{"unchanged", DefaultPipelineState, nil},
+		{"depth function", modify(func(ps *PipelineState) { ps.Depth.Func = gl.LEQUAL }),
+			[]string{"Disable", "DepthMask", "DepthFunc"}},
+		{"scissor box", modify(func(ps *PipelineState) { ps.Scissor.Width = 4 }),
+			[]string{"Disable", "Scissor"}},
+		{"color mask", modify(func(ps *PipelineState) { ps.ColorMask[3] = false }),
+			[]string{"ColorMask"}},
+		{"uniform blend", DefaultPipelineState.WithBlend(BlendAlpha),
+			[]string{"Enable", "BlendEquationSeparate", "BlendFuncSeparate"}},
+		{"per attachment blend", perAttachment,
+			[]string{"Enablei", "BlendEquationSeparatei", "BlendFuncSeparatei"}},
+		{"wireframe", modify(func(ps *PipelineState) { ps.Raster.PolygonMode = gl.LINE }),
+			[]string{"Disable", "CullFace", "FrontFace", "PolygonMode"}},
+	}
+	b := newStateCalls(t, 4, 6, false)
+	r := NewRenderer()
+	if err := r.applyState(); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		// Start each case from the default state.
+		r.SetState(DefaultPipelineState)
+		if err := r.applyState(); err != nil {
+			t.Fatal(err)
+		}
+		r.SetState(test.state)
+		b.calls = nil
+		if err := r.applyState(); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if !equal(b.calls, test.want) {
+			t.Errorf("%s: got calls %v, want %v", test.name, b.calls, test.want)
+		}
+		// Applying the same state again sends nothing.
+		b.calls = nil
+		if err := r.applyState(); err != nil {
+			t.Fatal(err)
+		}
+		if len(b.calls) != 0 {
+			t.Errorf("%s: reapplying sent %v", test.name, b.calls)
+		}
+	}
+	checkNoGLErrors(t)
+}
+
+func TestApplyStateUnsupported(t *testing.T) {
+	perAttachment := DefaultPipelineState
+	perAttachment.Blend[1] = BlendAdditive
+	wireframe := DefaultPipelineState
+	wireframe.Raster.PolygonMode = gl.LINE
+	tests := []struct {
+		name         string
+		major, minor int
+		es           bool
+		state        PipelineState
+		wantErr      error
+	}{
+		{name: "per attachment blend on 3.3", major: 3, minor: 3, state: perAttachment, wantErr: ErrUnsupported},
+		{name: "per attachment blend on 4.0", major: 4, minor: 0, state: perAttachment},
+		{name: "per attachment blend on ES 3.0", major: 3, es: true, state: perAttachment, wantErr: ErrUnsupported},
+		{name: "per attachment blend on ES 3.2", major: 3, minor: 2, es: true, state: perAttachment},
+		{name: "uniform blend on 3.3", major: 3, minor: 3, state: DefaultPipelineState.WithBlend(BlendAlpha)},
+		{name: "wireframe on ES", major: 3, minor: 2, es: true, state: wireframe, wantErr: ErrUnsupported},
+		{name: "fill on ES", major: 3, minor: 2, es: true, state: DefaultPipelineState},
+	}
+	for _, test := range tests {
+		b := newStateCalls(t, test.major, test.minor, test.es)
+		r := NewRenderer()
+		r.SetState(test.state)
+		if err := r.applyState(); err != test.wantErr {
+			t.Errorf("%s: got error %v, want %v", test.name, err, test.wantErr)
+		}
+		if test.wantErr != nil && len(b.calls) != 0 {
+			t.Errorf("%s: unsupported state sent %v", test.name, b.calls)
+		}
+		if test.es {
+			for _, call := range b.calls {
+				if call == "PolygonMode" {
+					t.Errorf("%s: glPolygonMode called on OpenGL ES", test.name)
+				}
+			}
+		}
+	}
+	checkNoGLErrors(t)
+}
@@ -0,0 +1,153 @@
+package shaders
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// TextureFormat describes how texels are stored by OpenGL and how they
+// are laid out in client memory when uploaded or read back.
+type TextureFormat struct {
+	// Internal is the sized internal format of the texture storage. Valid
+	// formats include gl.RGBA8, gl.RGBA16F, gl.RGBA32F, gl.DEPTH24_STENCIL8 etc.
+	Internal uint32
+	// Format is the client pixel format, i.e: gl.RGBA, gl.RED, gl.DEPTH_STENCIL.
+	Format uint32
+	// Type is the client type of each component, i.e: gl.UNSIGNED_BYTE, gl.FLOAT.
+	Type uint32
+}
+
+// Commonly used texture formats.
+var (
+	FormatRGBA8   = TextureFormat{Internal: gl.RGBA8, Format: gl.RGBA, Type: gl.UNSIGNED_BYTE}
+	FormatRGBA16  = TextureFormat{Internal: gl.RGBA16, Format: gl.RGBA, Type: gl.UNSIGNED_SHORT}
+	FormatRGBA16F = TextureFormat{Internal: gl.RGBA16F, Format: gl.RGBA, Type: gl.FLOAT}
+	FormatRGBA32F = TextureFormat{Internal: gl.RGBA32F, Format: gl.RGBA, Type: gl.FLOAT}
+	FormatR8      = TextureFormat{Internal: gl.R8, Format: gl.RED, Type: gl.UNSIGNED_BYTE}
+	FormatR32F    = TextureFormat{Internal: gl.R32F, Format: gl.RED, Type: gl.FLOAT}
+	// Depth and stencil formats.
+	FormatDepth24         = TextureFormat{Internal: gl.DEPTH_COMPONENT24, Format: gl.DEPTH_COMPONENT, Type: gl.UNSIGNED_INT}
+	FormatDepth32F        = TextureFormat{Internal: gl.DEPTH_COMPONENT32F, Format: gl.DEPTH_COMPONENT, Type: gl.FLOAT}
+	FormatDepth24Stencil8 = TextureFormat{Internal: gl.DEPTH24_STENCIL8, Format: gl.DEPTH_STENCIL, Type: gl.UNSIGNED_INT_24_8}
+)
+
+// attachment returns the framebuffer attachment point the format is bound to.
+// Color formats return gl.COLOR_ATTACHMENT0.
+func (f TextureFormat) attachment() uint32 {
+	switch f.Format {
+	case gl.DEPTH_COMPONENT:
+		return gl.DEPTH_ATTACHMENT
+	case gl.DEPTH_STENCIL:
+		return gl.DEPTH_STENCIL_ATTACHMENT
+	case gl.STENCIL_INDEX:
+		return gl.STENCIL_ATTACHMENT
+	}
+	return gl.COLOR_ATTACHMENT0
+}
+
+// Texture is a two dimensional OpenGL texture.
+type Texture struct {
+	// Renderer ID. If using OpenGL is the id set on texture creation.
+	rid    uint32
+	width  int32
+	height int32
+	format TextureFormat
+}
+
+// NewTexture creates a width*height texture with the given format.
+// data may be nil, in which case the texture contents are undefined, which is
+// the usual case for render targets. Textures are created with linear filtering
+// and clamp to edge wrapping.
+func NewTexture[T any](width, height int, format TextureFormat, data []T) (Texture, error) {
+	if width <= 0 || height <= 0 {
+		return Texture{}, errors.New("texture dimensions must be positive")
+	}
+	var ptr unsafe.Pointer
+	if len(data) > 0 {
+		ptr = unsafe.Pointer(&data[0])
+	}
+	tex := Texture{width: int32(width), height: int32(height), format: format}
+	gl.GenTextures(1, &tex.rid)
+	trackCreate(kindTexture, tex.rid)
+	gl.BindTexture(gl.TEXTURE_2D, tex.rid)
+	// Rows of client data are tightly packed.
+	gl.PixelStorei(gl.UNPACK_ALIGNMENT, 1)
+	gl.TexImage2D(gl.TEXTURE_2D, 0, int32(format.Internal), tex.width, tex.height, 0, format.Format, format.Type, ptr)
+	if err := checkCall("glTexImage2D"); err != nil {
+		tex.Delete()
+		return Texture{}, err
+	}
+	// Without mipmaps the default minifying filter leaves the texture incomplete.
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE)
+	if err := glCheckError(); err != nil {
+		tex.Delete()
+		return Texture{}, err
+	}
+	return tex, nil
+}
+
+func (t Texture) Bind() {
+	trackUse(kindTexture, t.rid)
+	gl.BindTexture(gl.TEXTURE_2D, t.rid)
+	logCall("glBindTexture")
+}
+
+// BindUnit binds the texture to texture unit `unit`, which is the value
+// a sampler uniform must be set to in order to read the texture.
+func (t Texture) BindUnit(unit int) {
+	trackUse(kindTexture, t.rid)
+	gl.ActiveTexture(gl.TEXTURE0 + uint32(unit))
+	logCall("glActiveTexture")
+	gl.BindTexture(gl.TEXTURE_2D, t.rid)
+	logCall("glBindTexture")
+}
+
+func (t Texture) Unbind() {
+	gl.BindTexture(gl.TEXTURE_2D, 0)
+}
+
+func (t Texture) Delete() {
+	if trackDelete(kindTexture, t.rid) {
+		gl.DeleteTextures(1, &t.rid)
+	}
+}
+
+// Size returns the dimensions of the texture in texels.
+func (t Texture) Size() (width, height int) {
+	return int(t.width), int(t.height)
+}
+
+// Format returns the format the texture was created with.
+func (t Texture) Format() TextureFormat { return t.format }
+
+// SetFilter sets the minifying and magnifying filters of the texture,
+// i.e: gl.NEAREST, gl.LINEAR. The texture is left bound.
+func (t Texture) SetFilter(min, mag int32) error {
+	t.Bind()
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, min)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, mag)
+	return checkErrorAfter("glTexParameteri")
+}
+
+// SetWrap sets the wrapping mode for the s and t texture coordinates,
+// i.e: gl.CLAMP_TO_EDGE, gl.REPEAT, gl.MIRRORED_REPEAT. The texture is left bound.
+func (t Texture) SetWrap(s, tc int32) error {
+	t.Bind()
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, s)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, tc)
+	return checkErrorAfter("glTexParameteri")
+}
+
+// GenerateMipmap computes the mipmap levels of the texture from its base level,
+// which mipmap minifying filters such as gl.LINEAR_MIPMAP_LINEAR sample.
+// The texture is left bound.
+func (t Texture) GenerateMipmap() error {
+	t.Bind()
+	gl.GenerateMipmap(gl.TEXTURE_2D)
+	return checkErrorAfter("glGenerateMipmap")
+}
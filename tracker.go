@@ -0,0 +1,179 @@
+package shaders
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/exp/slog"
+)
+
+// EnableTracking starts recording every OpenGL object created through the package
+// along with the stack of its creation. While enabled, deleting an object twice or
+// binding a deleted object is logged as an error to logger. Objects created before
+// the call are not tracked. If logger is nil slog.Default() is used.
+//
+// OpenGL reuses the names of deleted objects so use after delete is only detected
+// until a new object of the same kind is created with the same name.
+//
+//	shaders.EnableTracking(nil)
+//	defer shaders.ReportLeaks()
+func EnableTracking(logger *slog.Logger) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	objTracker.Store(&tracker{
+		logger:  logger,
+		objects: make(map[trackedKey]*trackedObject),
+	})
+}
+
+// DisableTracking stops recording OpenGL objects and discards the records.
+func DisableTracking() {
+	objTracker.Store(nil)
+}
+
+// ReportLeaks logs a warning for every tracked object that has not been
+// deleted along with its creation stack and returns the number of leaked objects.
+// It is meant to be called at shutdown before the context is destroyed.
+func ReportLeaks() (leaked int) {
+	t := objTracker.Load()
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, obj := range t.objects {
+		if obj.deleted != nil {
+			continue
+		}
+		leaked++
+		t.logger.Warn("leaked OpenGL object", "kind", key.kind.String(), "id", key.id, "created", formatStack(obj.created))
+	}
+	return leaked
+}
+
+// objectKind enumerates the OpenGL object namespaces, objects of different kinds may share an id.
+type objectKind uint8
+
+const (
+	kindBuffer objectKind = iota
+	kindVertexArray
+	kindProgram
+	kindTexture
+	kindFramebuffer
+	kindRenderbuffer
+)
+
+func (k objectKind) String() string {
+	switch k {
+	case kindBuffer:
+		return "buffer"
+	case kindVertexArray:
+		return "vertex array"
+	case kindProgram:
+		return "program"
+	case kindTexture:
+		return "texture"
+	case kindFramebuffer:
+		return "framebuffer"
+	case kindRenderbuffer:
+		return "renderbuffer"
+	}
+	return "unknown"
+}
+
+var objTracker atomic.Pointer[tracker]
+
+type tracker struct {
+	mu      sync.Mutex
+	logger  *slog.Logger
+	objects map[trackedKey]*trackedObject
+}
+
+type trackedKey struct {
+	kind objectKind
+	id   uint32
+}
+
+type trackedObject struct {
+	// Program counters of stack at creation and deletion.
+	// deleted is nil while the object is alive.
+	created []uintptr
+	deleted []uintptr
+}
+
+// trackCreate records the creation of an object if tracking is enabled.
+func trackCreate(kind objectKind, id uint32) {
+	t := objTracker.Load()
+	if t == nil || id == 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// Overwrites records of deleted objects whose name was reused.
+	t.objects[trackedKey{kind: kind, id: id}] = &trackedObject{created: callers()}
+}
+
+// trackDelete records the deletion of an object. It returns false
+// if the object was already deleted, in which case the caller should not
+// delete it again since the name may now belong to an object created elsewhere.
+func trackDelete(kind objectKind, id uint32) bool {
+	t := objTracker.Load()
+	if t == nil || id == 0 {
+		return true
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	obj := t.objects[trackedKey{kind: kind, id: id}]
+	if obj == nil {
+		// Created before tracking was enabled.
+		return true
+	}
+	if obj.deleted != nil {
+		t.logger.Error("OpenGL object deleted twice", nil, "kind", kind.String(), "id", id,
+			"created", formatStack(obj.created), "deleted", formatStack(obj.deleted), "now", formatStack(callers()))
+		return false
+	}
+	obj.deleted = callers()
+	return true
+}
+
+// trackUse logs an error if the object being used was deleted.
+func trackUse(kind objectKind, id uint32) {
+	t := objTracker.Load()
+	if t == nil || id == 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	obj := t.objects[trackedKey{kind: kind, id: id}]
+	if obj != nil && obj.deleted != nil {
+		t.logger.Error("OpenGL object used after delete", nil, "kind", kind.String(), "id", id,
+			"created", formatStack(obj.created), "deleted", formatStack(obj.deleted), "now", formatStack(callers()))
+	}
+}
+
+// callers returns the stack of the caller of the exported package function
+// that created, deleted or used an object.
+func callers() []uintptr {
+	pcs := make([]uintptr, 32)
+	// Skip runtime.Callers, callers and the track* function.
+	n := runtime.Callers(3, pcs)
+	return pcs[:n]
+}
+
+func formatStack(pcs []uintptr) string {
+	var sb strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&sb, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,110 @@
+package shaders
+
+import (
+	"fmt"
+	"time"
+)
+
+// StandardUniforms holds the inputs The Book of Shaders and Shadertoy
+// provide to shaders and sets them on programs with Apply:
+//
+//	u_time       float  Time in seconds.
+//	u_delta      float  Delta in seconds.
+//	u_resolution vec2   Width and Height.
+//	u_mouse      vec2   Mouse position.
+//	iTime        float  Time in seconds.
+//	iTimeDelta   float  Delta in seconds.
+//	iFrameRate   float  Inverse of Delta.
+//	iFrame       int    Frame.
+//	iResolution  vec3   Width, Height and a pixel aspect ratio of 1.
+//	iMouse       vec4   Mouse position and click, see SetMouse.
+//	iDate        vec4   Year, month starting at 0, day and seconds since midnight of Date.
+//
+// Positions are in pixels with the origin at the bottom left of the viewport.
+type StandardUniforms struct {
+	// Time in seconds since the shader started running.
+	Time float32
+	// Delta is the time in seconds between the previous frame and this one.
+	Delta float32
+	// Frame is the number of frames drawn before this one.
+	Frame int
+	// Width and Height of the viewport in pixels.
+	Width, Height float32
+	// Date is the local time. The zero value sets iDate to zero.
+	Date time.Time
+
+	mouse     [2]float32
+	iMouse    [4]float32
+	mouseDown bool
+}
+
+// SetMouse sets the mouse position and whether its button is pressed. It should be
+// called once per frame as iMouse follows Shadertoy's click semantics:
+// xy is the position during the last frame the button was pressed. z and w are the
+// position of the last click. z is positive while the button is pressed and w only
+// during the frame the button was pressed, i.e: sign(iMouse.zw) is (1, 1) on click,
+// (1, -1) while dragging and (-1, -1) after release.
+// u_mouse is always set to the current position.
+func (u *StandardUniforms) SetMouse(x, y float32, pressed bool) {
+	u.mouse = [2]float32{x, y}
+	switch {
+	case pressed && !u.mouseDown:
+		u.iMouse = [4]float32{x, y, x, y}
+	case pressed:
+		u.iMouse[0], u.iMouse[1] = x, y
+		u.iMouse[3] = -abs32(u.iMouse[3])
+	case u.mouseDown:
+		u.iMouse[2] = -abs32(u.iMouse[2])
+		u.iMouse[3] = -abs32(u.iMouse[3])
+	}
+	u.mouseDown = pressed
+}
+
+// Mouse returns the current value of iMouse.
+func (u *StandardUniforms) Mouse() [4]float32 { return u.iMouse }
+
+// Apply sets the standard uniforms the program uses. The program must be bound.
+// Uniforms the program lacks, or that were optimized away, are skipped.
+func (u *StandardUniforms) Apply(p Program) error {
+	var date [4]float32
+	if !u.Date.IsZero() {
+		d := u.Date
+		midnight := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, d.Location())
+		date = [4]float32{float32(d.Year()), float32(d.Month() - 1), float32(d.Day()), float32(d.Sub(midnight).Seconds())}
+	}
+	var frameRate float32
+	if u.Delta > 0 {
+		frameRate = 1 / u.Delta
+	}
+	uniforms := []struct {
+		name string
+		set  func(name string) error
+	}{
+		{"u_time\x00", func(name string) error { return p.SetUniformName1f(name, u.Time) }},
+		{"u_delta\x00", func(name string) error { return p.SetUniformName1f(name, u.Delta) }},
+		{"u_resolution\x00", func(name string) error { return p.SetUniformName2f(name, u.Width, u.Height) }},
+		{"u_mouse\x00", func(name string) error { return p.SetUniformName2f(name, u.mouse[0], u.mouse[1]) }},
+		{"iTime\x00", func(name string) error { return p.SetUniformName1f(name, u.Time) }},
+		{"iTimeDelta\x00", func(name string) error { return p.SetUniformName1f(name, u.Delta) }},
+		{"iFrameRate\x00", func(name string) error { return p.SetUniformName1f(name, frameRate) }},
+		{"iFrame\x00", func(name string) error { return p.SetUniformName1i(name, int32(u.Frame)) }},
+		{"iResolution\x00", func(name string) error { return p.SetUniformName3f(name, u.Width, u.Height, 1) }},
+		{"iMouse\x00", func(name string) error {
+			return p.SetUniformName4f(name, u.iMouse[0], u.iMouse[1], u.iMouse[2], u.iMouse[3])
+		}},
+		{"iDate\x00", func(name string) error { return p.SetUniformName4f(name, date[0], date[1], date[2], date[3]) }},
+	}
+	for _, uniform := range uniforms {
+		if err := IgnoreNoUniform(uniform.set(uniform.name)); err != nil {
+			return fmt.Errorf("uniform %s: %w", uniform.name[:len(uniform.name)-1], err)
+		}
+	}
+	return nil
+}
+
+func abs32(f float32) float32 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
@@ -0,0 +1,120 @@
+package shaders
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestSetMouse(t *testing.T) {
+	type step struct {
+		x, y    float32
+		pressed bool
+		want    [4]float32
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{"no click", []step{
+			{10, 20, false, [4]float32{0, 0, 0, 0}},
+			{11, 21, false, [4]float32{0, 0, 0, 0}},
+		}},
+		{"click drag release", []step{
+			// The click sets zw positive for one frame.
+			{10, 20, true, [4]float32{10, 20, 10, 20}},
+			// Dragging moves xy and w turns negative.
+			{12, 22, true, [4]float32{12, 22, 10, -20}},
+			{14, 24, true, [4]float32{14, 24, 10, -20}},
+			// On release z turns negative and xy stop following the mouse.
+			{16, 26, false, [4]float32{14, 24, -10, -20}},
+			{18, 28, false, [4]float32{14, 24, -10, -20}},
+		}},
+		{"second click", []step{
+			{10, 20, true, [4]float32{10, 20, 10, 20}},
+			{10, 20, false, [4]float32{10, 20, -10, -20}},
+			{30, 40, true, [4]float32{30, 40, 30, 40}},
+			{31, 41, true, [4]float32{31, 41, 30, -40}},
+		}},
+	}
+	for _, test := range tests {
+		var u StandardUniforms
+		for i, s := range test.steps {
+			u.SetMouse(s.x, s.y, s.pressed)
+			if got := u.Mouse(); got != s.want {
+				t.Errorf("%s: frame %d: got iMouse %v, want %v", test.name, i, got, s.want)
+			}
+			if u.mouse != [2]float32{s.x, s.y} {
+				t.Errorf("%s: frame %d: got u_mouse %v, want current position", test.name, i, u.mouse)
+			}
+		}
+	}
+}
+
+func TestStandardUniformsApply(t *testing.T) {
+	b := newFake(t)
+	prog, err := NewFragmentProgram(`#version 330
+uniform float u_time;
+uniform vec2 u_mouse;
+uniform int iFrame;
+uniform float iFrameRate;
+uniform vec3 iResolution;
+uniform vec4 iMouse;
+uniform vec4 iDate;
+out vec4 c;
+void main() { c = vec4(0.0); }
+` + "\x00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer prog.Delete()
+	u := StandardUniforms{
+		Time: 2.5, Delta: 0.25, Frame: 7, Width: 40, Height: 20,
+		Date: time.Date(2024, time.March, 5, 1, 2, 3, 0, time.UTC),
+	}
+	u.SetMouse(3, 4, true)
+	prog.Bind()
+	if err := u.Apply(prog); err != nil {
+		t.Fatal(err)
+	}
+	p := b.Program(prog.rid)
+	tests := []struct {
+		name string
+		want []float32
+	}{
+		{"u_time", []float32{2.5}},
+		{"u_mouse", []float32{3, 4}},
+		{"iFrame", []float32{7}},
+		{"iFrameRate", []float32{4}},
+		{"iResolution", []float32{40, 20, 1}},
+		{"iMouse", []float32{3, 4, 3, 4}},
+		{"iDate", []float32{2024, 2, 5, 3723}},
+		// Uniforms the program lacks are skipped.
+		{"u_delta", nil},
+		{"iTime", nil},
+	}
+	for _, test := range tests {
+		if got := p.Uniform(test.name); !equal(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+	checkNoGLErrors(t)
+}
+
+func TestIgnoreNoUniform(t *testing.T) {
+	other := errors.New("other")
+	tests := []struct {
+		err, want error
+	}{
+		{nil, nil},
+		{ErrNoUniform, nil},
+		{fmt.Errorf("uniform u_time: %w", ErrNoUniform), nil},
+		{other, other},
+	}
+	for _, test := range tests {
+		if got := IgnoreNoUniform(test.err); got != test.want {
+			t.Errorf("IgnoreNoUniform(%v): got %v, want %v", test.err, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package shaders
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// glVersion is an OpenGL or OpenGL ES version.
+type glVersion struct {
+	major, minor int
+	es           bool
+}
+
+func (v glVersion) atLeast(major, minor int) bool {
+	return v.major > major || v.major == major && v.minor >= minor
+}
+
+// adaptVersions is true if #version directives are adapted to the context, see SetVersionAdaptation.
+var adaptVersions = true
+
+// SetVersionAdaptation enables or disables rewriting of #version directives the
+// current context can not compile, which is enabled by default. Disable it to compile
+// sources exactly as written, i.e: to find out which GLSL versions a driver supports.
+func SetVersionAdaptation(enabled bool) {
+	adaptVersions = enabled
+}
+
+// contextInfo caches the version and extensions of the current context
+// since they are checked on every compile, debug group and dispatch.
+type contextInfo struct {
+	valid      bool
+	version    glVersion
+	extensions map[string]bool
+}
+
+var ctxInfo contextInfo
+
+// InvalidateContext discards the version and extensions of the current context
+// cached by the package, they are queried again on next use. Call it after making
+// another context current, i.e: with headless.Context.MakeCurrent. SetBackend
+// and Capabilities invalidate the cache.
+func InvalidateContext() {
+	ctxInfo = contextInfo{}
+}
+
+// currentContext returns the cached version and extensions of the current context.
+func currentContext() *contextInfo {
+	if !ctxInfo.valid {
+		setContextInfo(queryVersion(), extensions())
+	}
+	return &ctxInfo
+}
+
+func setContextInfo(v glVersion, exts []string) {
+	set := make(map[string]bool, len(exts))
+	for _, ext := range exts {
+		set[ext] = true
+	}
+	// A zero version means there is no usable context yet, query again next time.
+	ctxInfo = contextInfo{valid: v.major != 0, version: v, extensions: set}
+}
+
+// contextVersion returns the version of the current context.
+func contextVersion() glVersion {
+	return currentContext().version
+}
+
+func queryVersion() glVersion {
+	var major, minor int32
+	gl.GetIntegerv(gl.MAJOR_VERSION, &major)
+	gl.GetIntegerv(gl.MINOR_VERSION, &minor)
+	es := strings.HasPrefix(gl.GoStr(gl.GetString(gl.VERSION)), "OpenGL ES")
+	return glVersion{major: int(major), minor: int(minor), es: es}
+}
+
+// callableVersion returns the version of the current context limited to the
+// version of the functions implemented by the backend, see backend.Backend.Version.
+func callableVersion() glVersion {
+	v := contextVersion()
+	major, minor, es := gl.Version()
+	if es != v.es {
+		// Bindings for another API, only extensions may be relied upon.
+		return glVersion{es: v.es}
+	}
+	if !v.atLeast(major, minor) {
+		return v
+	}
+	return glVersion{major: major, minor: minor, es: es}
+}
+
+// contextSupports reports whether the current context is at least desktop
+// OpenGL major.minor or exposes the extension ext.
+func contextSupports(major, minor int, ext string) bool {
+	v := callableVersion()
+	if !v.es && v.atLeast(major, minor) {
+		return true
+	}
+	return HasExtension(ext)
+}
+
+// contextSupportsES reports whether the current context is at least OpenGL ES
+// major.minor or exposes the extension ext. ext may be empty.
+func contextSupportsES(major, minor int, ext string) bool {
+	v := callableVersion()
+	if v.es && v.atLeast(major, minor) {
+		return true
+	}
+	return ext != "" && HasExtension(ext)
+}
+
+// hasCompute reports whether compute shaders and memory barriers may be used.
+func hasCompute() bool {
+	return contextSupports(4, 3, "GL_ARB_compute_shader") || contextSupportsES(3, 1, "")
+}
+
+// glslVersion returns the newest GLSL version the context compiles, i.e: 410 for
+// OpenGL 4.1 or 300 for OpenGL ES 3.0, where it is the GLSL ES version.
+func glslVersion(v glVersion) int {
+	switch {
+	case v.es && v.major >= 3:
+		return 300 + 10*v.minor
+	case v.es:
+		return 100
+	case v.atLeast(3, 3):
+		return 100*v.major + 10*v.minor
+	case v.atLeast(3, 0):
+		return 130 + 10*v.minor
+	}
+	return 120
+}
+
+// glslESEquivalent maps GLSL ES versions to the desktop GLSL version with the same features.
+var glslESEquivalent = map[int]int{300: 330, 310: 430, 320: 450}
+
+// adaptVersion rewrites the #version directive of a shader source so that the
+// current context compiles it. Desktop GLSL newer than the context is lowered to
+// the context's version, GLSL ES is translated to its desktop equivalent on desktop
+// contexts and desktop GLSL to GLSL ES on OpenGL ES contexts, i.e: "#version 410 core"
+// becomes "#version 300 es" on OpenGL ES 3.0. Fragment shaders translated to GLSL ES
+// are given a default float precision. Line numbers reported by the compiler are
+// preserved. Sources with no #version directive are returned unchanged.
+func adaptVersion(shaderType uint32, src string) string {
+	if !adaptVersions {
+		return src
+	}
+	lineStart, lineEnd, num, profile := findVersion(src)
+	if lineEnd == 0 {
+		return src
+	}
+	ctx := contextVersion()
+	target := glslVersion(ctx)
+	srcES := profile == "es" || num == 100
+	var directive string
+	switch {
+	case ctx.es && srcES && num <= target:
+		return src
+	case ctx.es:
+		directive = "#version " + strconv.Itoa(target)
+		if target >= 300 {
+			directive += " es"
+		}
+		if !srcES && shaderType == gl.FRAGMENT_SHADER {
+			// GLSL ES fragment shaders have no default float precision. The #line directive
+			// makes the following line keep its number, GLSL ES 3.00 section 3.4.
+			line := strings.Count(src[:lineEnd], "\n") + 2
+			if target < 300 {
+				// Before GLSL ES 3.00 #line sets the number of the directive's own line.
+				line--
+			}
+			directive += "\nprecision highp float;\n#line " + strconv.Itoa(line)
+		}
+	case srcES && num != 100:
+		num = glslESEquivalent[num]
+		if num == 0 || num > target {
+			num = target
+		}
+		directive = "#version " + strconv.Itoa(num)
+	case !srcES && num > target:
+		directive = "#version " + strconv.Itoa(target)
+		if profile != "" && target >= 150 {
+			directive += " " + profile
+		}
+	default:
+		return src
+	}
+	return src[:lineStart] + directive + src[lineEnd:]
+}
+
+// findVersion returns the bounds of the #version directive line in src, excluding
+// the newline, and its version number and profile. It returns lineEnd==0 if there is
+// no directive. Only blank lines and line comments may precede the directive.
+func findVersion(src string) (lineStart, lineEnd, num int, profile string) {
+	for lineStart < len(src) {
+		lineEnd = strings.IndexByte(src[lineStart:], '\n')
+		if lineEnd < 0 {
+			lineEnd = len(strings.TrimSuffix(src, "\x00"))
+		} else {
+			lineEnd += lineStart
+		}
+		line := strings.TrimSpace(src[lineStart:lineEnd])
+		switch {
+		case line == "" || strings.HasPrefix(line, "//"):
+			lineStart = lineEnd + 1
+			continue
+		case !strings.HasPrefix(line, "#"):
+			return 0, 0, 0, ""
+		}
+		fields := strings.Fields(strings.TrimPrefix(line, "#"))
+		if len(fields) < 2 || len(fields) > 3 || fields[0] != "version" {
+			return 0, 0, 0, ""
+		}
+		n, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return 0, 0, 0, ""
+		}
+		if len(fields) == 3 {
+			profile = fields[2]
+		}
+		return lineStart, lineEnd, n, profile
+	}
+	return 0, 0, 0, ""
+}
@@ -0,0 +1,214 @@
+package shaders
+
+import (
+	"testing"
+
+	"github.com/soypat/shaders/backend/fake"
+	"github.com/soypat/shaders/internal/gl"
+)
+
+// newFakeVersion sets a fake backend emulating an OpenGL or OpenGL ES major.minor context.
+func newFakeVersion(t *testing.T, major, minor int, es bool) *fake.Backend {
+	t.Helper()
+	b := fake.New(16, 16)
+	b.Major, b.Minor, b.ES = int32(major), int32(minor), es
+	SetBackend(b)
+	return b
+}
+
+func TestAdaptVersion(t *testing.T) {
+	type context struct {
+		major, minor int
+		es           bool
+	}
+	var (
+		gl46   = context{4, 6, false}
+		gl41   = context{4, 1, false}
+		gl33   = context{3, 3, false}
+		gl32   = context{3, 2, false}
+		gl30   = context{3, 0, false}
+		gles20 = context{2, 0, true}
+		gles30 = context{3, 0, true}
+		gles32 = context{3, 2, true}
+	)
+	const (
+		vertex   = gl.VERTEX_SHADER
+		fragment = gl.FRAGMENT_SHADER
+	)
+	tests := []struct {
+		name       string
+		ctx        context
+		shaderType uint32
+		src, want  string
+	}{
+		{
+			name: "desktop fragment to ES", ctx: gles30, shaderType: fragment,
+			src:  "#version 410 core\nout vec4 c;\n",
+			want: "#version 300 es\nprecision highp float;\n#line 2\nout vec4 c;\n",
+		},
+		{
+			name: "desktop vertex to ES", ctx: gles30, shaderType: vertex,
+			src:  "#version 330 core\nin vec3 p;\n",
+			want: "#version 300 es\nin vec3 p;\n",
+		},
+		{
+			// GLSL ES 1.00 #line sets the number of its own line.
+			name: "desktop fragment to GLSL ES 1.00", ctx: gles20, shaderType: fragment,
+			src:  "#version 120\nvoid main() {}\n",
+			want: "#version 100\nprecision highp float;\n#line 1\nvoid main() {}\n",
+		},
+		{
+			name: "ES source supported", ctx: gles32, shaderType: fragment,
+			src:  "#version 300 es\nprecision mediump float;\n",
+			want: "#version 300 es\nprecision mediump float;\n",
+		},
+		{
+			name: "ES source above context", ctx: gles30, shaderType: fragment,
+			src:  "#version 310 es\nprecision mediump float;\n",
+			want: "#version 300 es\nprecision mediump float;\n",
+		},
+		{
+			name: "ES to desktop", ctx: gl41, shaderType: fragment,
+			src:  "#version 300 es\nprecision mediump float;\n",
+			want: "#version 330\nprecision mediump float;\n",
+		},
+		{
+			name: "ES equivalent above context", ctx: gl33, shaderType: vertex,
+			src:  "#version 310 es\n",
+			want: "#version 330\n",
+		},
+		{
+			name: "lowered to context", ctx: gl41, shaderType: vertex,
+			src:  "#version 460 core\n",
+			want: "#version 410 core\n",
+		},
+		{
+			name: "profile kept at 150", ctx: gl32, shaderType: vertex,
+			src:  "#version 330 compatibility\n",
+			want: "#version 150 compatibility\n",
+		},
+		{
+			name: "profile dropped below 150", ctx: gl30, shaderType: vertex,
+			src:  "#version 330 core\n",
+			want: "#version 130\n",
+		},
+		{
+			name: "supported desktop version", ctx: gl46, shaderType: fragment,
+			src:  "#version 330 core\nout vec4 c;\n",
+			want: "#version 330 core\nout vec4 c;\n",
+		},
+		{
+			name: "version 100 on desktop", ctx: gl46, shaderType: fragment,
+			src:  "#version 100\nprecision mediump float;\n",
+			want: "#version 100\nprecision mediump float;\n",
+		},
+		{
+			name: "version 100 on ES", ctx: gles30, shaderType: fragment,
+			src:  "#version 100\nprecision mediump float;\n",
+			want: "#version 100\nprecision mediump float;\n",
+		},
+		{
+			name: "after comments", ctx: gles30, shaderType: fragment,
+			src:  "// Header.\n\n  // More.\n#version 410 core\nout vec4 c;\n",
+			want: "// Header.\n\n  // More.\n#version 300 es\nprecision highp float;\n#line 5\nout vec4 c;\n",
+		},
+		{
+			name: "no trailing newline", ctx: gl41, shaderType: vertex,
+			src:  "#version 460",
+			want: "#version 410",
+		},
+		{
+			name: "null terminated", ctx: gl41, shaderType: vertex,
+			src:  "#version 460\x00",
+			want: "#version 410\x00",
+		},
+		{
+			name: "indented directive", ctx: gl41, shaderType: vertex,
+			src:  "  #  version 460 core  \nvoid main() {}\n",
+			want: "#version 410 core\nvoid main() {}\n",
+		},
+		{
+			name: "no directive", ctx: gles30, shaderType: fragment,
+			src:  "void main() {}\n",
+			want: "void main() {}\n",
+		},
+		{
+			name: "code before directive", ctx: gl41, shaderType: vertex,
+			src:  "float x;\n#version 460\n",
+			want: "float x;\n#version 460\n",
+		},
+		{
+			name: "block comment before directive", ctx: gl41, shaderType: vertex,
+			src:  "/* Header. */\n#version 460\n",
+			want: "/* Header. */\n#version 460\n",
+		},
+	}
+	for _, test := range tests {
+		newFakeVersion(t, test.ctx.major, test.ctx.minor, test.ctx.es)
+		if got := adaptVersion(test.shaderType, test.src); got != test.want {
+			t.Errorf("%s: got\n%q\nwant\n%q", test.name, got, test.want)
+		}
+	}
+	checkNoGLErrors(t)
+}
+
+func TestSetVersionAdaptation(t *testing.T) {
+	newFakeVersion(t, 3, 0, true)
+	SetVersionAdaptation(false)
+	defer SetVersionAdaptation(true)
+	const src = "#version 410 core\nout vec4 c;\n"
+	if got := adaptVersion(gl.FRAGMENT_SHADER, src); got != src {
+		t.Errorf("adaptation disabled: got %q", got)
+	}
+}
+
+func TestGLSLVersion(t *testing.T) {
+	tests := []struct {
+		v    glVersion
+		want int
+	}{
+		{glVersion{major: 4, minor: 6}, 460},
+		{glVersion{major: 3, minor: 3}, 330},
+		{glVersion{major: 3, minor: 2}, 150},
+		{glVersion{major: 3, minor: 0}, 130},
+		{glVersion{major: 2, minor: 1}, 120},
+		{glVersion{major: 3, minor: 1, es: true}, 310},
+		{glVersion{major: 2, minor: 0, es: true}, 100},
+	}
+	for _, test := range tests {
+		if got := glslVersion(test.v); got != test.want {
+			t.Errorf("%+v: got %d, want %d", test.v, got, test.want)
+		}
+	}
+}
+
+// bindings reports the version of the functions of the go-gl package wrapped by a backend.
+type bindings struct {
+	*fake.Backend
+	major, minor int
+	es           bool
+}
+
+func (b bindings) Version() (major, minor int, es bool) { return b.major, b.minor, b.es }
+
+func TestCallableVersion(t *testing.T) {
+	tests := []struct {
+		ctx, bindings glVersion
+		want          glVersion
+	}{
+		// Functions newer than the bindings can not be called.
+		{ctx: glVersion{major: 4, minor: 6}, bindings: glVersion{major: 4, minor: 1}, want: glVersion{major: 4, minor: 1}},
+		// Nor functions newer than the context.
+		{ctx: glVersion{major: 3, minor: 3}, bindings: glVersion{major: 4, minor: 1}, want: glVersion{major: 3, minor: 3}},
+		{ctx: glVersion{major: 3, minor: 2, es: true}, bindings: glVersion{major: 3, es: true}, want: glVersion{major: 3, es: true}},
+		// Desktop bindings on an ES context only have extensions.
+		{ctx: glVersion{major: 3, es: true}, bindings: glVersion{major: 4, minor: 6}, want: glVersion{es: true}},
+	}
+	for _, test := range tests {
+		b := newFakeVersion(t, test.ctx.major, test.ctx.minor, test.ctx.es)
+		SetBackend(bindings{Backend: b, major: test.bindings.major, minor: test.bindings.minor, es: test.bindings.es})
+		if got := callableVersion(); got != test.want {
+			t.Errorf("context %+v with bindings %+v: got %+v, want %+v", test.ctx, test.bindings, got, test.want)
+		}
+	}
+}